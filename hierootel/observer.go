@@ -0,0 +1,266 @@
+// Package hierootel adapts the execution events of the Hiero SDK to OpenTelemetry spans and metrics.
+//
+//	observer, err := hierootel.NewObserver(tracerProvider, meterProvider)
+//	if err != nil {
+//		return err
+//	}
+//	client.SetExecutionObserver(observer)
+//
+// Every transaction and query execution is traced as a span named after its type, with its attempts,
+// backoffs, unhealthy nodes and receipt polls recorded as span events. Every TopicMessageQuery
+// subscription is traced as a span from the opening of its stream until it fails or completes. The spans
+// are children of the span in the context given to ExecuteWithContext or Stream, joining the trace of the caller.
+package hierootel
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"sync"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer and meter of the Observer.
+const InstrumentationName = "github.com/hiero-ledger/hiero-sdk-go/hierootel"
+
+// Observer is a hiero.ExecutionObserver emitting OpenTelemetry spans and metrics.
+type Observer struct {
+	tracer trace.Tracer
+
+	executions    sync.Map // execution ID -> trace.Span
+	subscriptions sync.Map // subscription ID -> trace.Span
+
+	executionDuration metric.Float64Histogram
+	attempts          metric.Int64Counter
+	grpcDuration      metric.Float64Histogram
+	responses         metric.Int64Counter
+	backoffs          metric.Int64Counter
+	unhealthyNodes    metric.Int64Counter
+	receiptPolls      metric.Int64Counter
+	topicMessages     metric.Int64Counter
+}
+
+var _ hiero.ExecutionObserver = (*Observer)(nil)
+
+// NewObserver creates an Observer recording spans with the tracerProvider and metrics with the
+// meterProvider. A nil provider uses the global one.
+func NewObserver(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) (*Observer, error) {
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+
+	meter := meterProvider.Meter(InstrumentationName)
+	observer := &Observer{
+		tracer: tracerProvider.Tracer(InstrumentationName),
+	}
+
+	var err error
+	if observer.executionDuration, err = meter.Float64Histogram("hiero.execution.duration",
+		metric.WithUnit("ms"), metric.WithDescription("Duration of transaction and query executions, including retries")); err != nil {
+		return nil, err
+	}
+	if observer.attempts, err = meter.Int64Counter("hiero.attempts",
+		metric.WithDescription("Attempts of transaction and query executions")); err != nil {
+		return nil, err
+	}
+	if observer.grpcDuration, err = meter.Float64Histogram("hiero.grpc.duration",
+		metric.WithUnit("ms"), metric.WithDescription("Latency of gRPC calls to consensus nodes")); err != nil {
+		return nil, err
+	}
+	if observer.responses, err = meter.Int64Counter("hiero.responses",
+		metric.WithDescription("Statuses answered by consensus nodes")); err != nil {
+		return nil, err
+	}
+	if observer.backoffs, err = meter.Int64Counter("hiero.backoffs",
+		metric.WithDescription("Backoffs between attempts")); err != nil {
+		return nil, err
+	}
+	if observer.unhealthyNodes, err = meter.Int64Counter("hiero.node.unhealthy",
+		metric.WithDescription("Nodes marked unhealthy")); err != nil {
+		return nil, err
+	}
+	if observer.receiptPolls, err = meter.Int64Counter("hiero.receipt.polls",
+		metric.WithDescription("Polls of consensus nodes for transaction receipts")); err != nil {
+		return nil, err
+	}
+	if observer.topicMessages, err = meter.Int64Counter("hiero.topic.messages",
+		metric.WithDescription("Messages delivered to topic subscribers")); err != nil {
+		return nil, err
+	}
+
+	return observer, nil
+}
+
+// OnExecutionStart implements hiero.ExecutionObserver.
+func (observer *Observer) OnExecutionStart(event hiero.ExecutionStartEvent) {
+	_, span := observer.tracer.Start(_ParentContext(event.Context), event.Name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("hiero.request.name", event.Name),
+			attribute.String("hiero.request.id", event.RequestID),
+			attribute.Bool("hiero.request.transaction", event.IsTransaction),
+			attribute.Int("hiero.request.max_attempts", event.MaxAttempts),
+		))
+	observer.executions.Store(event.ExecutionID, span)
+}
+
+// OnExecutionEnd implements hiero.ExecutionObserver.
+func (observer *Observer) OnExecutionEnd(event hiero.ExecutionEndEvent) {
+	outcome := "success"
+	if event.Err != nil {
+		outcome = "error"
+	}
+	observer.executionDuration.Record(context.Background(), _Milliseconds(event.Duration.Seconds()),
+		metric.WithAttributes(attribute.String("hiero.request.name", event.Name), attribute.String("hiero.outcome", outcome)))
+
+	value, ok := observer.executions.LoadAndDelete(event.ExecutionID)
+	if !ok {
+		return
+	}
+
+	span := value.(trace.Span)
+	if event.Err != nil {
+		span.RecordError(event.Err)
+		span.SetStatus(codes.Error, event.Err.Error())
+	}
+	span.End()
+}
+
+// OnAttemptStart implements hiero.ExecutionObserver.
+func (observer *Observer) OnAttemptStart(event hiero.AttemptStartEvent) {
+	observer.attempts.Add(context.Background(), 1, metric.WithAttributes(attribute.String("hiero.request.name", event.Name)))
+	observer._AddEvent(event.ExecutionID, "attempt", attribute.Int64("hiero.attempt", event.Attempt))
+}
+
+// OnNodeChosen implements hiero.ExecutionObserver.
+func (observer *Observer) OnNodeChosen(event hiero.NodeChosenEvent) {
+	observer._AddEvent(event.ExecutionID, "node chosen",
+		attribute.Int64("hiero.attempt", event.Attempt),
+		attribute.String("hiero.node.account_id", event.NodeAccountID.String()),
+		attribute.String("hiero.node.address", event.Address),
+		attribute.Bool("hiero.node.healthy", event.Healthy))
+}
+
+// OnGrpcCall implements hiero.ExecutionObserver.
+func (observer *Observer) OnGrpcCall(event hiero.GrpcCallEvent) {
+	observer.grpcDuration.Record(context.Background(), _Milliseconds(event.Latency.Seconds()),
+		metric.WithAttributes(
+			attribute.String("hiero.request.name", event.Name),
+			attribute.String("hiero.node.account_id", event.NodeAccountID.String()),
+			attribute.String("rpc.grpc.status_code", event.Code.String()),
+		))
+	observer._AddEvent(event.ExecutionID, "grpc call",
+		attribute.Int64("hiero.attempt", event.Attempt),
+		attribute.String("rpc.grpc.status_code", event.Code.String()),
+		attribute.Int64("hiero.grpc.latency_ms", event.Latency.Milliseconds()))
+}
+
+// OnResponseStatus implements hiero.ExecutionObserver.
+func (observer *Observer) OnResponseStatus(event hiero.ResponseStatusEvent) {
+	observer.responses.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("hiero.request.name", event.Name),
+		attribute.String("hiero.status", event.Status.String()),
+	))
+	observer._AddEvent(event.ExecutionID, "response",
+		attribute.Int64("hiero.attempt", event.Attempt),
+		attribute.String("hiero.status", event.Status.String()))
+}
+
+// OnBackoff implements hiero.ExecutionObserver.
+func (observer *Observer) OnBackoff(event hiero.BackoffEvent) {
+	observer.backoffs.Add(context.Background(), 1, metric.WithAttributes(attribute.String("hiero.request.name", event.Name)))
+	observer._AddEvent(event.ExecutionID, "backoff",
+		attribute.Int64("hiero.attempt", event.Attempt),
+		attribute.Int64("hiero.backoff_ms", event.Backoff.Milliseconds()))
+}
+
+// OnNodeUnhealthy implements hiero.ExecutionObserver.
+func (observer *Observer) OnNodeUnhealthy(event hiero.NodeUnhealthyEvent) {
+	observer.unhealthyNodes.Add(context.Background(), 1, metric.WithAttributes(attribute.String("hiero.node.account_id", event.NodeAccountID.String())))
+	observer._AddEvent(event.ExecutionID, "node unhealthy",
+		attribute.String("hiero.node.account_id", event.NodeAccountID.String()),
+		attribute.String("hiero.node.address", event.Address))
+}
+
+// OnReceiptPoll implements hiero.ExecutionObserver.
+func (observer *Observer) OnReceiptPoll(event hiero.ReceiptPollEvent) {
+	observer.receiptPolls.Add(context.Background(), 1, metric.WithAttributes(attribute.String("hiero.status", event.Status.String())))
+	observer._AddEvent(event.ExecutionID, "receipt poll",
+		attribute.Int64("hiero.attempt", event.Attempt),
+		attribute.String("hiero.transaction_id", event.TransactionID.String()),
+		attribute.String("hiero.status", event.Status.String()),
+		attribute.Bool("hiero.receipt.done", event.Done))
+}
+
+// OnTopicStream implements hiero.ExecutionObserver.
+func (observer *Observer) OnTopicStream(event hiero.TopicStreamEvent) {
+	switch event.Type {
+	case hiero.TopicStreamSubscribed:
+		if _, ok := observer.subscriptions.Load(event.SubscriptionID); ok {
+			observer._AddSubscriptionEvent(event, "resubscribed")
+			return
+		}
+
+		_, span := observer.tracer.Start(_ParentContext(event.Context), "TopicMessageQuery",
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("hiero.topic_id", event.TopicID.String()),
+				attribute.String("hiero.mirror.address", event.Address),
+			))
+		observer.subscriptions.Store(event.SubscriptionID, span)
+	case hiero.TopicStreamMessage:
+		observer.topicMessages.Add(context.Background(), 1, metric.WithAttributes(attribute.String("hiero.topic_id", event.TopicID.String())))
+	case hiero.TopicStreamRetry:
+		observer._AddSubscriptionEvent(event, "retry",
+			attribute.Int64("hiero.backoff_ms", event.Backoff.Milliseconds()))
+	case hiero.TopicStreamError, hiero.TopicStreamCompleted:
+		value, ok := observer.subscriptions.LoadAndDelete(event.SubscriptionID)
+		if !ok {
+			return
+		}
+
+		span := value.(trace.Span)
+		if event.Err != nil {
+			span.RecordError(event.Err)
+			span.SetStatus(codes.Error, event.Err.Error())
+		}
+		span.End()
+	}
+}
+
+func (observer *Observer) _AddEvent(executionID uint64, name string, attributes ...attribute.KeyValue) {
+	if value, ok := observer.executions.Load(executionID); ok {
+		value.(trace.Span).AddEvent(name, trace.WithAttributes(attributes...))
+	}
+}
+
+func (observer *Observer) _AddSubscriptionEvent(event hiero.TopicStreamEvent, name string, attributes ...attribute.KeyValue) {
+	if value, ok := observer.subscriptions.Load(event.SubscriptionID); ok {
+		attributes = append(attributes,
+			attribute.Int64("hiero.attempt", int64(event.Attempt)),
+			attribute.String("hiero.mirror.address", event.Address))
+		value.(trace.Span).AddEvent(name, trace.WithAttributes(attributes...))
+	}
+}
+
+// _ParentContext returns the context of the caller, so the spans of the SDK join its trace
+func _ParentContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+
+	return ctx
+}
+
+func _Milliseconds(seconds float64) float64 {
+	return seconds * 1000
+}
@@ -0,0 +1,188 @@
+package hierootel
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/hierotest"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/status"
+)
+
+func _NewTestObserver(t *testing.T) (*Observer, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	observer, err := NewObserver(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	)
+	require.NoError(t, err)
+
+	return observer, spans, reader
+}
+
+func _Sum(t *testing.T, reader *sdkmetric.ManualReader, name string) int64 {
+	var metrics metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &metrics))
+
+	var total int64
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != name {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				total += point.Value
+			}
+		}
+	}
+
+	return total
+}
+
+func TestExecutionSpan(t *testing.T) {
+	observer, spans, reader := _NewTestObserver(t)
+	node := hiero.AccountID{Account: 3}
+
+	observer.OnExecutionStart(hiero.ExecutionStartEvent{ExecutionID: 1, Name: "TransferTransaction", IsTransaction: true, MaxAttempts: 10})
+	for attempt := int64(1); attempt <= 2; attempt++ {
+		observer.OnAttemptStart(hiero.AttemptStartEvent{ExecutionID: 1, Name: "TransferTransaction", Attempt: attempt})
+		observer.OnNodeChosen(hiero.NodeChosenEvent{ExecutionID: 1, Name: "TransferTransaction", Attempt: attempt, NodeAccountID: node, Healthy: true})
+		observer.OnGrpcCall(hiero.GrpcCallEvent{ExecutionID: 1, Name: "TransferTransaction", Attempt: attempt, NodeAccountID: node, Latency: time.Millisecond})
+	}
+	observer.OnResponseStatus(hiero.ResponseStatusEvent{ExecutionID: 1, Name: "TransferTransaction", Attempt: 1, Status: hiero.StatusBusy})
+	observer.OnBackoff(hiero.BackoffEvent{ExecutionID: 1, Name: "TransferTransaction", Attempt: 1, Backoff: 250 * time.Millisecond})
+	observer.OnNodeUnhealthy(hiero.NodeUnhealthyEvent{ExecutionID: 1, Name: "TransferTransaction", NodeAccountID: node})
+	observer.OnExecutionEnd(hiero.ExecutionEndEvent{ExecutionID: 1, Name: "TransferTransaction", Duration: time.Second, Err: errors.New("exceptional precheck status BUSY")})
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, "TransferTransaction", ended[0].Name())
+	require.Equal(t, codes.Error, ended[0].Status().Code)
+	// 2 attempts, 2 nodes chosen, 2 gRPC calls, a response, a backoff, an unhealthy node and the error
+	require.Len(t, ended[0].Events(), 10)
+
+	require.Equal(t, int64(2), _Sum(t, reader, "hiero.attempts"))
+	require.Equal(t, int64(1), _Sum(t, reader, "hiero.backoffs"))
+	require.Equal(t, int64(1), _Sum(t, reader, "hiero.node.unhealthy"))
+}
+
+func TestTopicSubscriptionSpan(t *testing.T) {
+	observer, spans, reader := _NewTestObserver(t)
+	topicID := hiero.TopicID{Topic: 7}
+
+	observer.OnTopicStream(hiero.TopicStreamEvent{SubscriptionID: 2, Type: hiero.TopicStreamSubscribed, TopicID: topicID})
+	observer.OnTopicStream(hiero.TopicStreamEvent{SubscriptionID: 2, Type: hiero.TopicStreamMessage, TopicID: topicID, SequenceNumber: 1})
+	observer.OnTopicStream(hiero.TopicStreamEvent{SubscriptionID: 2, Type: hiero.TopicStreamRetry, TopicID: topicID, Backoff: time.Second})
+	observer.OnTopicStream(hiero.TopicStreamEvent{SubscriptionID: 2, Type: hiero.TopicStreamSubscribed, TopicID: topicID, Attempt: 1})
+	observer.OnTopicStream(hiero.TopicStreamEvent{SubscriptionID: 2, Type: hiero.TopicStreamMessage, TopicID: topicID, SequenceNumber: 2})
+	require.Empty(t, spans.Ended())
+
+	observer.OnTopicStream(hiero.TopicStreamEvent{SubscriptionID: 2, Type: hiero.TopicStreamCompleted, TopicID: topicID})
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, "TopicMessageQuery", ended[0].Name())
+	require.Equal(t, codes.Unset, ended[0].Status().Code)
+	require.Len(t, ended[0].Events(), 2)
+
+	require.Equal(t, int64(2), _Sum(t, reader, "hiero.topic.messages"))
+}
+
+func TestSpansJoinTraceOfCaller(t *testing.T) {
+	observer, spans, _ := _NewTestObserver(t)
+
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	ctx, parent := tracerProvider.Tracer("caller").Start(context.Background(), "caller")
+
+	observer.OnExecutionStart(hiero.ExecutionStartEvent{ExecutionID: 3, Name: "AccountBalanceQuery", Context: ctx})
+	observer.OnExecutionEnd(hiero.ExecutionEndEvent{ExecutionID: 3, Name: "AccountBalanceQuery"})
+	observer.OnTopicStream(hiero.TopicStreamEvent{SubscriptionID: 4, Type: hiero.TopicStreamSubscribed, Context: ctx})
+	observer.OnTopicStream(hiero.TopicStreamEvent{SubscriptionID: 4, Type: hiero.TopicStreamCompleted, Context: ctx})
+	parent.End()
+
+	ended := spans.Ended()
+	require.Len(t, ended, 3)
+	for _, span := range ended[:2] {
+		require.Equal(t, parent.SpanContext().TraceID(), span.SpanContext().TraceID())
+		require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+	}
+}
+
+// The context given to ExecuteWithContext reaches the observer
+func TestExecuteWithContextJoinsTraceOfCaller(t *testing.T) {
+	observer, spans, _ := _NewTestObserver(t)
+
+	client, err := hiero.ClientForNetworkV2(map[string]hiero.AccountID{"127.0.0.1:50211": {Account: 3}})
+	require.NoError(t, err)
+	defer client.Close()
+	client.SetExecutionObserver(observer)
+	client.SetMaxAttempts(1)
+
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	ctx, parent := tracerProvider.Tracer("caller").Start(context.Background(), "caller")
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err = hiero.NewAccountBalanceQuery().
+		SetAccountID(hiero.AccountID{Account: 1800}).
+		ExecuteWithContext(ctx, client)
+	require.Error(t, err)
+	parent.End()
+
+	ended := spans.Ended()
+	require.Len(t, ended, 2)
+	require.Equal(t, "AccountBalanceQuery", ended[0].Name())
+	require.Equal(t, parent.SpanContext().SpanID(), ended[0].Parent().SpanID())
+}
+
+func TestUnsubscribeEndsSubscriptionSpan(t *testing.T) {
+	observer, spans, _ := _NewTestObserver(t)
+
+	network, err := hierotest.NewNetwork(1)
+	require.NoError(t, err)
+	defer network.Close()
+	client, err := network.Client()
+	require.NoError(t, err)
+	client.SetExecutionObserver(observer)
+
+	handle, err := hiero.NewTopicMessageQuery().
+		SetTopicID(network.Ledger().CreateTopic("memo")).
+		SetErrorHandler(func(status.Status) {}).
+		Subscribe(client, func(hiero.TopicMessage) {})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return len(spans.Started()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	handle.Unsubscribe()
+	require.Eventually(t, func() bool { return len(spans.Ended()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	span := spans.Ended()[0]
+	require.Equal(t, "TopicMessageQuery", span.Name())
+	require.Equal(t, codes.Error, span.Status().Code)
+
+	observer.subscriptions.Range(func(key, value interface{}) bool {
+		t.Errorf("subscription %v is still tracked", key)
+		return true
+	})
+}
+
+func TestObserverWithClient(t *testing.T) {
+	observer, _, _ := _NewTestObserver(t)
+
+	client := hiero.ClientForNetwork(map[string]hiero.AccountID{})
+	defer client.Close()
+
+	client.SetExecutionObserver(observer)
+	require.Equal(t, observer, client.GetExecutionObserver())
+}
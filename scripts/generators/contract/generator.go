@@ -0,0 +1,577 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// Names the generated methods use for their own parameters and locals
+var reservedNames = map[string]bool{
+	"c": true, "client": true, "gas": true, "ctx": true, "err": true, "params": true, "result": true,
+	"data": true, "out": true, "abi": true, "flow": true, "resp": true, "receipt": true, "transaction": true,
+	"hex": true, "strings": true, "big": true, "context": true, "fmt": true, "hiero": true, "bytes": true,
+}
+
+func main() {
+	abiPath := flag.String("abi", "", "Path of the ABI JSON of the contract")
+	binPath := flag.String("bin", "", "Path of the hex bytecode of the contract, to generate its deploy functions")
+	pkg := flag.String("pkg", "", "Package of the generated file")
+	typeName := flag.String("type", "", "Go type of the binding, the file name of the ABI by default")
+	out := flag.String("out", "", "Path of the generated file, stdout by default")
+	flag.Parse()
+
+	if *abiPath == "" || *pkg == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	abiJSON, err := os.ReadFile(*abiPath)
+	if err != nil {
+		log.Fatalf("Error reading ABI %s: %v", *abiPath, err)
+	}
+
+	bytecode := ""
+	if *binPath != "" {
+		bin, err := os.ReadFile(*binPath)
+		if err != nil {
+			log.Fatalf("Error reading bytecode %s: %v", *binPath, err)
+		}
+		bytecode = strings.TrimPrefix(strings.TrimSpace(string(bin)), "0x")
+	}
+
+	if *typeName == "" {
+		*typeName = exportedName(strings.TrimSuffix(filepath.Base(*abiPath), filepath.Ext(*abiPath)))
+	}
+
+	source, err := generate(*pkg, *typeName, filepath.Base(*abiPath), string(abiJSON), bytecode)
+	if err != nil {
+		log.Fatalf("Error generating bindings: %v", err)
+	}
+
+	if *out == "" {
+		_, _ = os.Stdout.Write(source)
+		return
+	}
+	if err := os.WriteFile(*out, source, 0o644); err != nil { //nolint:gosec
+		log.Fatalf("Error writing file %s: %v", *out, err)
+	}
+}
+
+// generator writes the bindings of one contract
+type generator struct {
+	buf      bytes.Buffer
+	typeName string
+	abi      *hiero.ABI
+	// structs are the Go structs of the tuples, by name, and structOrder the order they were declared in
+	structs     map[string]string
+	structOrder []string
+}
+
+func generate(pkg string, typeName string, source string, abiJSON string, bytecode string) ([]byte, error) {
+	abi, err := hiero.NewABI(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{typeName: typeName, abi: abi, structs: map[string]string{}}
+	g.writeHeader(pkg, source, abiJSON, bytecode)
+	if bytecode != "" {
+		g.writeDeploy()
+	}
+	for _, name := range sortedKeys(abi.Methods) {
+		g.writeMethod(name, abi.Methods[name])
+	}
+	for _, name := range sortedKeys(abi.Events) {
+		g.writeEvent(name, abi.Events[name])
+	}
+	for _, name := range g.structOrder {
+		g.buf.WriteString(g.structs[name])
+	}
+
+	formatted, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated code does not compile: %w", err)
+	}
+	return formatted, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) writeHeader(pkg string, source string, abiJSON string, bytecode string) {
+	g.printf("// Code generated by scripts/generators/contract from %s. DO NOT EDIT.\n\n", source)
+	g.printf("package %s\n\n", pkg)
+	g.printf(`import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// Reference imports which not every contract uses
+var (
+	_ = bytes.Equal
+	_ = context.Background
+	_ = hex.DecodeString
+	_ = fmt.Errorf
+	_ = big.NewInt
+	_ = strings.TrimPrefix
+)
+
+`)
+
+	g.printf("// %sABI is the ABI of the %s contract\n", g.typeName, g.typeName)
+	g.printf("const %sABI = %s\n\n", g.typeName, quote(strings.TrimSpace(abiJSON)))
+	if bytecode != "" {
+		g.printf("// %sBytecode is the bytecode of the %s contract\n", g.typeName, g.typeName)
+		g.printf("const %sBytecode = %q\n\n", g.typeName, bytecode)
+	}
+
+	g.printf(`// %[1]s is a typed binding to a deployed %[1]s contract
+type %[1]s struct {
+	contractID hiero.ContractID
+	abi        *hiero.ABI
+}
+
+// New%[1]s binds the %[1]s contract deployed at contractID
+func New%[1]s(contractID hiero.ContractID) (*%[1]s, error) {
+	abi, err := hiero.NewABI(%[1]sABI)
+	if err != nil {
+		return nil, err
+	}
+
+	return &%[1]s{contractID: contractID, abi: abi}, nil
+}
+
+// ContractID returns the ID of the bound contract
+func (c *%[1]s) ContractID() hiero.ContractID {
+	return c.contractID
+}
+
+// ABI returns the parsed ABI of the contract
+func (c *%[1]s) ABI() *hiero.ABI {
+	return c.abi
+}
+
+// is%[1]sLog reports whether the log was emitted by the bound contract, matching its contract number or its EVM
+// address. A binding to an EVM address matches the logs reported by contract number once PopulateContract set it.
+func (c *%[1]s) is%[1]sLog(log hiero.ContractLogInfo) bool {
+	if log.ContractID.Shard != c.contractID.Shard || log.ContractID.Realm != c.contractID.Realm {
+		return false
+	}
+	if c.contractID.Contract != 0 && log.ContractID.Contract == c.contractID.Contract {
+		return true
+	}
+
+	return strings.EqualFold(log.ContractID.ToEvmAddress(), c.contractID.ToEvmAddress())
+}
+
+`, g.typeName)
+}
+
+func (g *generator) writeDeploy() {
+	var inputs []*hiero.TupleElem
+	if g.abi.Constructor != nil {
+		inputs = g.abi.Constructor.Inputs.TupleElems()
+	}
+	params, args := g.params(inputs, "Constructor")
+
+	g.printf("// New%sCreateFlow returns a ContractCreateFlow deploying the %s contract with the constructor arguments\n", g.typeName, g.typeName)
+	g.printf("func New%sCreateFlow(%s) (*hiero.ContractCreateFlow, error) {\n", g.typeName, params)
+	g.printf("flow := hiero.NewContractCreateFlow().SetBytecodeWithString(%sBytecode)\n", g.typeName)
+	if len(inputs) > 0 {
+		g.printf(`abi, err := hiero.NewABI(%sABI)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := abi.Constructor.Inputs.Encode([]interface{}{%s})
+	if err != nil {
+		return nil, err
+	}
+	flow.SetConstructorParametersRaw(params)
+`, g.typeName, args)
+	}
+	g.printf("\nreturn flow, nil\n}\n\n")
+
+	callParams := "client *hiero.Client, gas int64"
+	if params != "" {
+		callParams += ", " + params
+	}
+	g.printf("// Deploy%s deploys the %s contract with gas and the constructor arguments, and binds it\n", g.typeName, g.typeName)
+	g.printf("func Deploy%s(%s) (*%s, error) {\n", g.typeName, callParams, g.typeName)
+	g.printf(`flow, err := New%[1]sCreateFlow(%[2]s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := flow.SetGas(gas).Execute(client)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := resp.GetReceipt(client)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.ContractID == nil {
+		return nil, fmt.Errorf("receipt of the %[1]s deployment has no contract ID")
+	}
+
+	return New%[1]s(*receipt.ContractID)
+}
+
+`, g.typeName, args)
+}
+
+func (g *generator) writeMethod(key string, method *hiero.Method) {
+	name := exportedName(key)
+	inputs := method.Inputs.TupleElems()
+	params, args := g.params(inputs, name)
+	outputs := method.Outputs.TupleElems()
+	results, zero := g.results(outputs, name)
+	sig := method.Sig()
+
+	withParams := func(prefix string) string {
+		if params == "" {
+			return prefix
+		}
+		return prefix + ", " + params
+	}
+	returnErr := "return err"
+	if zero != "" {
+		returnErr = "return " + zero + ", err"
+	}
+
+	g.printf("// Pack%s encodes a call to %s\n", name, sig)
+	g.printf("func (c *%s) Pack%s(%s) ([]byte, error) {\n", g.typeName, name, params)
+	g.printf("return c.abi.Methods[%q].Encode([]interface{}{%s})\n}\n\n", key, args)
+
+	if len(outputs) > 0 {
+		g.printf("// Unpack%s decodes the result of %s\n", name, sig)
+		g.printf("func (c *%s) Unpack%s(data []byte) (%s) {\n", g.typeName, name, results)
+		if len(outputs) == 1 {
+			g.printf("var out struct {\nValue %s `abi:%q`\n}\n", g.goType(outputs[0].Elem, name+"Output"), elemKey(outputs[0], 0))
+			g.printf("if err := c.abi.Methods[%q].Outputs.DecodeStruct(data, &out); err != nil {\n%s\n}\n\n", key, returnErr)
+			g.printf("return out.Value, nil\n}\n\n")
+		} else {
+			g.printf("out := new(%s%sOutput)\n", g.typeName, name)
+			g.printf("if err := c.abi.Methods[%q].Outputs.DecodeStruct(data, out); err != nil {\n%s\n}\n\n", key, returnErr)
+			g.printf("return out, nil\n}\n\n")
+		}
+	}
+
+	unpack := func(data string) {
+		if len(outputs) > 0 {
+			g.printf("return c.Unpack%s(%s)\n}\n\n", name, data)
+		} else {
+			g.printf("return nil\n}\n\n")
+		}
+	}
+
+	if method.Const {
+		g.printf("// %s calls %s with a ContractCallQuery\n", name, sig)
+		g.printf("func (c *%s) %s(%s) (%s) {\n", g.typeName, name, withParams("client *hiero.Client, gas uint64"), results)
+		g.printf("params, err := c.Pack%s(%s)\nif err != nil {\n%s\n}\n\n", name, args, returnErr)
+		g.printf("result, err := hiero.NewContractCallQuery().SetContractID(c.contractID).SetGas(gas).SetFunctionParameters(params).Execute(client)\n")
+		g.printf("if err != nil {\n%s\n}\n\n", returnErr)
+		unpack("result.ContractCallResult")
+	} else {
+		g.printf("// %sTransaction returns a ContractExecuteTransaction calling %s\n", name, sig)
+		g.printf("func (c *%s) %sTransaction(%s) (*hiero.ContractExecuteTransaction, error) {\n", g.typeName, name, params)
+		g.printf("params, err := c.Pack%s(%s)\nif err != nil {\nreturn nil, err\n}\n\n", name, args)
+		g.printf("return hiero.NewContractExecuteTransaction().SetContractID(c.contractID).SetFunctionParameters(params), nil\n}\n\n")
+
+		g.printf("// %s executes %s with gas\n", name, sig)
+		g.printf("func (c *%s) %s(%s) (hiero.TransactionResponse, error) {\n", g.typeName, name, withParams("client *hiero.Client, gas uint64"))
+		g.printf("transaction, err := c.%sTransaction(%s)\nif err != nil {\nreturn hiero.TransactionResponse{}, err\n}\n\n", name, args)
+		g.printf("return transaction.SetGas(gas).Execute(client)\n}\n\n")
+	}
+
+	g.printf("// %sMirror simulates %s on the mirror node with a MirrorNodeContractCallQuery\n", name, sig)
+	g.printf("func (c *%s) %sMirror(%s) (%s) {\n", g.typeName, name, withParams("ctx context.Context, client *hiero.Client"), results)
+	g.printf("params, err := c.Pack%s(%s)\nif err != nil {\n%s\n}\n\n", name, args, returnErr)
+	g.printf("result, err := hiero.NewMirrorNodeContractCallQuery().SetContractID(c.contractID).SetFunctionParameters(params).ExecuteWithContext(ctx, client)\n")
+	g.printf("if err != nil {\n%s\n}\n\n", returnErr)
+	if len(outputs) > 0 {
+		g.printf("data, err := hex.DecodeString(strings.TrimPrefix(result, \"0x\"))\nif err != nil {\n%s\n}\n\n", returnErr)
+		unpack("data")
+	} else {
+		g.printf("_ = result\n")
+		unpack("")
+	}
+
+	if len(outputs) > 1 {
+		g.addStruct(g.typeName+name+"Output", fmt.Sprintf("%s%sOutput is the result of %s", g.typeName, name, sig), outputs, name, "")
+	}
+}
+
+func (g *generator) writeEvent(key string, event *hiero.Event) {
+	name := exportedName(key)
+	eventType := g.typeName + name
+	sig := event.Sig()
+
+	g.addStruct(eventType, fmt.Sprintf("%s is the %s event of the %s contract", eventType, sig, g.typeName),
+		event.Inputs.TupleElems(), name, "\n// Log is the decoded log\nLog hiero.ContractLogInfo `abi:\"-\"`\n")
+
+	g.printf("// Parse%s decodes a log of the %s event\n", name, sig)
+	g.printf(`func (c *%[1]s) Parse%[2]s(log hiero.ContractLogInfo) (*%[3]s, error) {
+	event := new(%[3]s)
+	if err := c.abi.Events[%[4]q].ParseLogStruct(log, event); err != nil {
+		return nil, err
+	}
+	event.Log = log
+
+	return event, nil
+}
+
+`, g.typeName, name, eventType, key)
+
+	if event.Anonymous {
+		return
+	}
+
+	g.printf("// Filter%s decodes the logs of the %s event emitted by the contract, skipping the other logs\n", name, sig)
+	g.printf(`func (c *%[1]s) Filter%[2]s(logs []hiero.ContractLogInfo) ([]*%[3]s, error) {
+	id := c.abi.Events[%[4]q].ID()
+	events := make([]*%[3]s, 0)
+	for _, log := range logs {
+		if len(log.Topics) == 0 || !bytes.Equal(log.Topics[0], id[:]) || !c.is%[1]sLog(log) {
+			continue
+		}
+
+		event, err := c.Parse%[2]s(log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+`, g.typeName, name, eventType, key)
+}
+
+// params returns the Go parameters of the inputs and the arguments passing them on
+func (g *generator) params(inputs []*hiero.TupleElem, owner string) (string, string) {
+	used := map[string]bool{}
+	params := make([]string, 0, len(inputs))
+	args := make([]string, 0, len(inputs))
+	for index, input := range inputs {
+		name := paramName(input.Name, index)
+		for reservedNames[name] || token.IsKeyword(name) || used[name] {
+			name += "_"
+		}
+		used[name] = true
+
+		params = append(params, name+" "+g.goType(input.Elem, owner+exportedName(fieldName(input.Name, index))))
+		args = append(args, name)
+	}
+
+	return strings.Join(params, ", "), strings.Join(args, ", ")
+}
+
+// results returns the Go results of the outputs, ending with an error, and the zero value of the first one
+func (g *generator) results(outputs []*hiero.TupleElem, owner string) (string, string) {
+	switch len(outputs) {
+	case 0:
+		return "error", ""
+	case 1:
+		typ := g.goType(outputs[0].Elem, owner+"Output")
+		return typ + ", error", zeroValue(outputs[0].Elem, typ)
+	default:
+		return fmt.Sprintf("*%s%sOutput, error", g.typeName, owner), "nil"
+	}
+}
+
+// addStruct declares a struct of the elems, with extra fields appended
+func (g *generator) addStruct(name string, doc string, elems []*hiero.TupleElem, owner string, extra string) {
+	if _, ok := g.structs[name]; ok {
+		return
+	}
+	g.structs[name] = ""
+	g.structOrder = append(g.structOrder, name)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "// %s\ntype %s struct {\n", doc, name)
+	used := map[string]bool{"Log": extra != ""}
+	for index, elem := range elems {
+		field := exportedName(fieldName(elem.Name, index))
+		for used[field] {
+			field += "_"
+		}
+		used[field] = true
+
+		typ := g.goType(elem.Elem, owner+field)
+		if elem.Indexed && indexedAsHash(elem.Elem) {
+			typ = "hiero.Hash"
+		}
+		fmt.Fprintf(&buf, "%s %s `abi:%q`\n", field, typ, elemKey(elem, index))
+	}
+	buf.WriteString(extra)
+	buf.WriteString("}\n\n")
+	g.structs[name] = buf.String()
+}
+
+// goType returns the Go type of an ABI type, declaring structs for its tuples named after their Solidity
+// struct or else after the owner
+func (g *generator) goType(t *hiero.Type, owner string) string {
+	return g.goTypeWithStruct(t, owner, t.InternalType())
+}
+
+func (g *generator) goTypeWithStruct(t *hiero.Type, owner string, internalType string) string {
+	if t.InternalType() != "" {
+		internalType = t.InternalType()
+	}
+
+	switch t.Kind() {
+	case hiero.KindSlice:
+		return "[]" + g.goTypeWithStruct(t.Elem(), owner, internalType)
+	case hiero.KindArray:
+		return fmt.Sprintf("[%d]%s", t.Size(), g.goTypeWithStruct(t.Elem(), owner, internalType))
+	case hiero.KindTuple:
+		name := g.typeName + owner
+		if structName := structNameOf(internalType); structName != "" {
+			name = g.typeName + structName
+		}
+		g.addStruct(name, name+" is a Solidity struct of the "+g.typeName+" contract", t.TupleElems(), strings.TrimPrefix(name, g.typeName), "")
+		return name
+	case hiero.KindAddress:
+		return "hiero.Address"
+	default:
+		return t.GoType().String()
+	}
+}
+
+// indexedAsHash reports whether an indexed event param of the type is logged as the hash of its value
+func indexedAsHash(t *hiero.Type) bool {
+	switch t.Kind() {
+	case hiero.KindString, hiero.KindBytes, hiero.KindSlice, hiero.KindArray, hiero.KindTuple:
+		return true
+	default:
+		return false
+	}
+}
+
+func zeroValue(t *hiero.Type, typ string) string {
+	switch t.Kind() {
+	case hiero.KindBool:
+		return "false"
+	case hiero.KindString:
+		return `""`
+	case hiero.KindInt, hiero.KindUInt:
+		if strings.HasPrefix(typ, "*") {
+			return "nil"
+		}
+		return "0"
+	case hiero.KindSlice, hiero.KindBytes:
+		return "nil"
+	default:
+		return typ + "{}"
+	}
+}
+
+// structNameOf returns the name of the Solidity struct of an internal type such as `struct Lib.Point[]`
+func structNameOf(internalType string) string {
+	if !strings.HasPrefix(internalType, "struct ") {
+		return ""
+	}
+
+	name := strings.TrimPrefix(internalType, "struct ")
+	if index := strings.Index(name, "["); index != -1 {
+		name = name[:index]
+	}
+	if index := strings.LastIndex(name, "."); index != -1 {
+		name = name[index+1:]
+	}
+
+	return exportedName(name)
+}
+
+// elemKey returns the key of the elem in the values decoded from an ABI tuple
+func elemKey(elem *hiero.TupleElem, index int) string {
+	if elem.Name == "" {
+		return strconv.Itoa(index)
+	}
+
+	return elem.Name
+}
+
+func fieldName(name string, index int) string {
+	if name == "" {
+		return fmt.Sprintf("Arg%d", index)
+	}
+
+	return name
+}
+
+func paramName(name string, index int) string {
+	name = strings.TrimLeft(name, "_")
+	if name == "" {
+		return fmt.Sprintf("arg%d", index)
+	}
+
+	runes := []rune(sanitize(name))
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+func exportedName(name string) string {
+	name = sanitize(strings.TrimLeft(name, "_"))
+	if name == "" {
+		return "X"
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	if !unicode.IsLetter(runes[0]) {
+		return "X" + string(runes)
+	}
+	return string(runes)
+}
+
+// sanitize drops the characters which cannot appear in Go identifiers
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+func quote(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+
+	return "`" + s + "`"
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
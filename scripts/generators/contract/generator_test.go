@@ -0,0 +1,175 @@
+package main
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testABI covers an overloaded function, tuple inputs and outputs, several outputs, indexed and anonymous events
+// and a constructor with arguments
+const testABI = `[
+  {"type": "constructor", "stateMutability": "nonpayable", "inputs": [{"name": "owner", "type": "address", "internalType": "address"}]},
+  {"type": "function", "name": "balanceOf", "stateMutability": "view", "inputs": [{"name": "account", "type": "address", "internalType": "address"}], "outputs": [{"name": "", "type": "uint256", "internalType": "uint256"}]},
+  {"type": "function", "name": "balanceOf", "stateMutability": "view", "inputs": [{"name": "account", "type": "address", "internalType": "address"}, {"name": "token", "type": "uint64", "internalType": "uint64"}], "outputs": [{"name": "", "type": "uint256", "internalType": "uint256"}]},
+  {"type": "function", "name": "echo", "stateMutability": "pure", "inputs": [{"name": "position", "type": "tuple", "internalType": "struct Vault.Position", "components": [{"name": "owner", "type": "address", "internalType": "address"}, {"name": "amount", "type": "uint256", "internalType": "uint256"}, {"name": "label", "type": "string", "internalType": "string"}]}], "outputs": [{"name": "", "type": "tuple", "internalType": "struct Vault.Position", "components": [{"name": "owner", "type": "address", "internalType": "address"}, {"name": "amount", "type": "uint256", "internalType": "uint256"}, {"name": "label", "type": "string", "internalType": "string"}]}]},
+  {"type": "function", "name": "stats", "stateMutability": "view", "inputs": [], "outputs": [{"name": "total", "type": "uint256", "internalType": "uint256"}, {"name": "paused", "type": "bool", "internalType": "bool"}]},
+  {"type": "function", "name": "deposit", "stateMutability": "payable", "inputs": [{"name": "amount", "type": "uint256", "internalType": "uint256"}], "outputs": []},
+  {"type": "event", "name": "Transfer", "anonymous": false, "inputs": [{"name": "from", "type": "address", "indexed": true, "internalType": "address"}, {"name": "to", "type": "address", "indexed": true, "internalType": "address"}, {"name": "amount", "type": "uint256", "indexed": false, "internalType": "uint256"}]},
+  {"type": "event", "name": "Note", "anonymous": true, "inputs": [{"name": "label", "type": "string", "indexed": true, "internalType": "string"}, {"name": "data", "type": "bytes", "indexed": false, "internalType": "bytes"}]}
+]`
+
+// roundTripMain packs a call to echo with the generated binding and unpacks it as its result, then filters the
+// Transfer logs of several contracts
+const roundTripMain = `package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+func main() {
+	vault, err := NewVault(hiero.ContractID{Contract: 1234})
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	position := VaultPosition{Owner: hiero.Address{1, 2, 3}, Amount: big.NewInt(1_000_000_007), Label: "savings"}
+	data, err := vault.PackEcho(position)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if !bytes.Equal(data[:4], vault.ABI().Methods["echo"].ID()) {
+		fmt.Printf("selector %x\n", data[:4])
+		os.Exit(1)
+	}
+
+	decoded, err := vault.UnpackEcho(data[4:])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fmt.Printf("%x %s %s\n", decoded.Owner[:3], decoded.Amount, decoded.Label)
+
+	// A binding to an EVM address keeps only the logs of its contract, not those of another contract emitting
+	// the same event
+	address, _ := hex.DecodeString("00112233445566778899aabbccddeeff00112233")
+	bound, err := hiero.ContractIDFromEvmAddress(0, 0, hex.EncodeToString(address))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	vault, err = NewVault(bound)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	id := vault.ABI().Events["Transfer"].ID()
+	amount := make([]byte, 32)
+	amount[31] = 7
+	logs := []hiero.ContractLogInfo{
+		{ContractID: hiero.ContractID{EvmAddress: address}, Topics: [][]byte{id[:], make([]byte, 32), make([]byte, 32)}, Data: amount},
+		{ContractID: hiero.ContractID{Contract: 99}, Topics: [][]byte{id[:], make([]byte, 32), make([]byte, 32)}, Data: amount},
+		{ContractID: hiero.ContractID{EvmAddress: make([]byte, 20)}, Topics: [][]byte{id[:], make([]byte, 32), make([]byte, 32)}, Data: amount},
+	}
+	transfers, err := vault.FilterTransfer(logs)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	for _, transfer := range transfers {
+		fmt.Printf("transfer of %s from %s\n", transfer.Amount, transfer.Log.ContractID.ToEvmAddress())
+	}
+}
+`
+
+func TestGenerate(t *testing.T) {
+	source, err := generate("main", "Vault", "Vault.abi", testABI, "6080604052")
+	require.NoError(t, err)
+
+	formatted, err := format.Source(source)
+	require.NoError(t, err)
+	require.Equal(t, string(formatted), string(source))
+
+	file, err := parser.ParseFile(token.NewFileSet(), "vault.go", source, 0)
+	require.NoError(t, err)
+	decls := map[string]bool{}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			decls[decl.Name.Name] = true
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok {
+					decls[spec.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	for _, name := range []string{
+		// The constructor with bytecode
+		"NewVaultCreateFlow", "DeployVault",
+		// The overloaded function
+		"BalanceOf", "BalanceOf0", "PackBalanceOf0",
+		// The tuple and the several outputs
+		"VaultPosition", "UnpackEcho", "VaultStatsOutput", "UnpackStats",
+		// The function without outputs
+		"Deposit", "DepositTransaction",
+		// The events
+		"VaultTransfer", "ParseTransfer", "FilterTransfer", "VaultNote", "ParseNote",
+	} {
+		require.True(t, decls[name], "%s is not generated", name)
+	}
+	// An anonymous event has no ID to filter the logs with
+	require.False(t, decls["FilterNote"])
+}
+
+func TestGenerateWithoutBytecode(t *testing.T) {
+	source, err := generate("main", "Vault", "Vault.abi", testABI, "")
+	require.NoError(t, err)
+	require.NotContains(t, string(source), "DeployVault")
+}
+
+func TestGenerateInvalidABI(t *testing.T) {
+	_, err := generate("main", "Vault", "Vault.abi", `{"type": "function"}`, "")
+	require.Error(t, err)
+}
+
+func TestGeneratedBindingBuildsAndRoundTrips(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated binding with the go command")
+	}
+
+	source, err := generate("main", "Vault", "Vault.abi", testABI, "6080604052")
+	require.NoError(t, err)
+
+	// The binding is built in the module, so it imports the SDK of this tree. The go command ignores the
+	// directories starting with an underscore in patterns.
+	dir, err := os.MkdirTemp(".", "_generated")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "vault.go"), source, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(roundTripMain), 0o600))
+
+	output, err := exec.Command("go", "run", "./"+filepath.Base(dir)).CombinedOutput()
+	require.NoError(t, err, string(output))
+	require.Equal(t, "010203 1000000007 savings\ntransfer of 7 from 00112233445566778899aabbccddeeff00112233\n", string(output))
+}
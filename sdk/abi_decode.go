@@ -30,6 +30,10 @@ func DecodeStruct(t *Type, input []byte, out interface{}) error {
 		return err
 	}
 
+	return decodeToStruct(val, out)
+}
+
+func decodeToStruct(val interface{}, out interface{}) error {
 	dc := &mapstructure.DecoderConfig{
 		Result:           out,
 		WeaklyTypedInput: true,
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package hiero
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// DecodedLog is a contract log decoded with the event which emitted it
+type DecodedLog struct {
+	Event  *Event
+	Values map[string]interface{}
+	Log    ContractLogInfo
+}
+
+// Sig returns the signature of the event
+func (e *Event) Sig() string {
+	return buildSignature(e.Name, e.Inputs)
+}
+
+// ID returns the id of the event, the first topic of its non anonymous logs
+func (e *Event) ID() Hash {
+	return Keccak256Hash([]byte(e.Sig()))
+}
+
+// ParseLog decodes the indexed params of the event from the topics of the log and the other params from its data.
+// Indexed params of dynamic types are only logged as the Keccak256 hash of their value, and are decoded as a Hash.
+// Params without a name are keyed by their position in the event.
+func (e *Event) ParseLog(log ContractLogInfo) (map[string]interface{}, error) {
+	topics := log.Topics
+	if !e.Anonymous {
+		if len(topics) == 0 {
+			return nil, fmt.Errorf("log has no topics")
+		}
+		if id := e.ID(); !bytes.Equal(topics[0], id[:]) {
+			return nil, fmt.Errorf("log is not an event %s", e.Sig())
+		}
+		topics = topics[1:]
+	}
+
+	values := make(map[string]interface{})
+	var data []*TupleElem
+	for index, input := range e.Inputs.TupleElems() {
+		name := input.Name
+		if name == "" {
+			name = strconv.Itoa(index)
+		}
+
+		if !input.Indexed {
+			data = append(data, &TupleElem{Name: name, Elem: input.Elem})
+			continue
+		}
+
+		if len(topics) == 0 {
+			return nil, fmt.Errorf("log is missing the topic of the indexed param %s", name)
+		}
+		topic := topics[0]
+		topics = topics[1:]
+		if len(topic) != 32 {
+			return nil, fmt.Errorf("topic of the indexed param %s has %d bytes, expected 32", name, len(topic))
+		}
+
+		if input.Elem.isDynamicType() || input.Elem.kind == KindTuple || input.Elem.kind == KindArray {
+			var hash Hash
+			copy(hash[:], topic)
+			values[name] = hash
+			continue
+		}
+
+		value, _, err := decode(input.Elem, topic)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the indexed param %s: %w", name, err)
+		}
+		values[name] = value
+	}
+
+	if len(topics) != 0 {
+		return nil, fmt.Errorf("log has %d more topics than the event %s", len(topics), e.Sig())
+	}
+
+	if len(data) == 0 {
+		return values, nil
+	}
+
+	decoded, err := Decode(NewTupleType(data), log.Data)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range decoded.(map[string]interface{}) {
+		values[name] = value
+	}
+
+	return values, nil
+}
+
+// ParseLogStruct decodes the log like ParseLog into the out struct, matching the params to the fields by name or
+// by their abi tag
+func (e *Event) ParseLogStruct(log ContractLogInfo, out interface{}) error {
+	values, err := e.ParseLog(log)
+	if err != nil {
+		return err
+	}
+
+	return decodeToStruct(values, out)
+}
+
+// GetEventByID returns the non anonymous event with the id, or nil if the ABI has none
+func (a *ABI) GetEventByID(id Hash) *Event {
+	for _, event := range a.Events {
+		if !event.Anonymous && event.ID() == id {
+			return event
+		}
+	}
+
+	return nil
+}
+
+// DecodeLog decodes the log with the event of the ABI matching its first topic
+func (a *ABI) DecodeLog(log ContractLogInfo) (*DecodedLog, error) {
+	if len(log.Topics) == 0 || len(log.Topics[0]) != 32 {
+		return nil, fmt.Errorf("log has no event signature topic")
+	}
+
+	var id Hash
+	copy(id[:], log.Topics[0])
+	event := a.GetEventByID(id)
+	if event == nil {
+		return nil, fmt.Errorf("no event of the ABI has the id 0x%x", id[:])
+	}
+
+	values, err := event.ParseLog(log)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DecodedLog{Event: event, Values: values, Log: log}, nil
+}
+
+// DecodeLogs decodes the logs of the contract function result emitted by events of the ABI, in order. Logs of
+// other events, emitted by other contracts called by the function, are skipped.
+func (a *ABI) DecodeLogs(result ContractFunctionResult) ([]DecodedLog, error) {
+	decoded := make([]DecodedLog, 0, len(result.LogInfo))
+	for index, log := range result.LogInfo {
+		if len(log.Topics) == 0 || len(log.Topics[0]) != 32 {
+			continue
+		}
+
+		var id Hash
+		copy(id[:], log.Topics[0])
+		event := a.GetEventByID(id)
+		if event == nil {
+			continue
+		}
+
+		values, err := event.ParseLog(log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode log %d: %w", index, err)
+		}
+		decoded = append(decoded, DecodedLog{Event: event, Values: values, Log: log})
+	}
+
+	return decoded, nil
+}
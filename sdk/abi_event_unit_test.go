@@ -0,0 +1,159 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestEventABI(t *testing.T) *ABI {
+	abi, err := NewABIFromList([]string{
+		"event Transfer(address indexed from, address indexed to, uint256 value)",
+		"event Renamed(string indexed oldName, string newName, uint8)",
+	})
+	require.NoError(t, err)
+
+	return abi
+}
+
+func _TestNewType(t *testing.T, s string) *Type {
+	typ, err := NewType(s)
+	require.NoError(t, err)
+
+	return typ
+}
+
+func _TestTopic(t *testing.T, typ string, value interface{}) []byte {
+	encoded, err := Encode([]interface{}{value}, _TestNewType(t, "tuple("+typ+")"))
+	require.NoError(t, err)
+
+	return encoded
+}
+
+func _TestTransferLog(t *testing.T, abi *ABI, from, to Address, value int64) ContractLogInfo {
+	id := abi.Events["Transfer"].ID()
+	data, err := Encode([]interface{}{big.NewInt(value)}, _TestNewType(t, "tuple(uint256)"))
+	require.NoError(t, err)
+
+	return ContractLogInfo{
+		ContractID: ContractID{Contract: 5},
+		Topics:     [][]byte{id[:], _TestTopic(t, "address", from), _TestTopic(t, "address", to)},
+		Data:       data,
+	}
+}
+
+func TestUnitEventID(t *testing.T) {
+	t.Parallel()
+
+	abi := _TestEventABI(t)
+	event := abi.Events["Transfer"]
+
+	assert.Equal(t, "Transfer(address,address,uint256)", event.Sig())
+	assert.Equal(t, "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", hex.EncodeToString(event.ID().Bytes()))
+	assert.Equal(t, event, abi.GetEventByID(event.ID()))
+	assert.Nil(t, abi.GetEventByID(Hash{}))
+}
+
+func TestUnitEventParseLog(t *testing.T) {
+	t.Parallel()
+
+	abi := _TestEventABI(t)
+	from := BytesToAddress([]byte{1})
+	to := BytesToAddress([]byte{2})
+
+	values, err := abi.Events["Transfer"].ParseLog(_TestTransferLog(t, abi, from, to, 42))
+	require.NoError(t, err)
+	assert.Equal(t, from, values["from"])
+	assert.Equal(t, to, values["to"])
+	assert.Equal(t, big.NewInt(42), values["value"])
+
+	var transfer struct {
+		From  Address
+		To    Address
+		Value *big.Int
+	}
+	require.NoError(t, abi.Events["Transfer"].ParseLogStruct(_TestTransferLog(t, abi, from, to, 42), &transfer))
+	assert.Equal(t, from, transfer.From)
+	assert.Equal(t, to, transfer.To)
+	assert.Equal(t, big.NewInt(42), transfer.Value)
+}
+
+func TestUnitEventParseLogIndexedDynamicAndUnnamed(t *testing.T) {
+	t.Parallel()
+
+	abi := _TestEventABI(t)
+	event := abi.Events["Renamed"]
+	id := event.ID()
+	data, err := Encode([]interface{}{"new", uint8(3)}, _TestNewType(t, "tuple(string,uint8)"))
+	require.NoError(t, err)
+	oldName := Keccak256Hash([]byte("old"))
+
+	values, err := event.ParseLog(ContractLogInfo{Topics: [][]byte{id[:], oldName[:]}, Data: data})
+	require.NoError(t, err)
+	assert.Equal(t, oldName, values["oldName"])
+	assert.Equal(t, "new", values["newName"])
+	assert.Equal(t, uint8(3), values["2"])
+}
+
+func TestUnitEventParseLogErrors(t *testing.T) {
+	t.Parallel()
+
+	abi := _TestEventABI(t)
+	event := abi.Events["Transfer"]
+	log := _TestTransferLog(t, abi, Address{}, Address{}, 1)
+
+	_, err := abi.Events["Renamed"].ParseLog(log)
+	require.ErrorContains(t, err, "not an event Renamed(string,string,uint8)")
+
+	_, err = event.ParseLog(ContractLogInfo{Topics: log.Topics[:2], Data: log.Data})
+	require.ErrorContains(t, err, "missing the topic of the indexed param to")
+
+	_, err = event.ParseLog(ContractLogInfo{Topics: append(log.Topics, log.Topics[1]), Data: log.Data})
+	require.ErrorContains(t, err, "more topics")
+
+	_, err = event.ParseLog(ContractLogInfo{Topics: log.Topics})
+	require.Error(t, err)
+
+	_, err = abi.DecodeLog(ContractLogInfo{Topics: [][]byte{make([]byte, 32)}})
+	require.ErrorContains(t, err, "no event of the ABI")
+}
+
+func TestUnitABIDecodeLogs(t *testing.T) {
+	t.Parallel()
+
+	abi := _TestEventABI(t)
+	from := BytesToAddress([]byte{1})
+	to := BytesToAddress([]byte{2})
+	other := Keccak256Hash([]byte("Other()"))
+
+	result := ContractFunctionResult{LogInfo: []ContractLogInfo{
+		_TestTransferLog(t, abi, from, to, 1),
+		{Topics: [][]byte{other[:]}},
+		{},
+		_TestTransferLog(t, abi, to, from, 2),
+	}}
+
+	decoded, err := abi.DecodeLogs(result)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	assert.Equal(t, "Transfer", decoded[0].Event.Name)
+	assert.Equal(t, big.NewInt(1), decoded[0].Values["value"])
+	assert.Equal(t, to, decoded[1].Values["from"])
+	assert.Equal(t, ContractID{Contract: 5}, decoded[1].Log.ContractID)
+
+	single, err := abi.DecodeLog(result.LogInfo[0])
+	require.NoError(t, err)
+	assert.Equal(t, from, single.Values["from"])
+
+	result.LogInfo[3].Data = nil
+	_, err = abi.DecodeLogs(result)
+	require.ErrorContains(t, err, "failed to decode log 3")
+}
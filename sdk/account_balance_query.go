@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -70,11 +71,16 @@ func (q *AccountBalanceQuery) GetContractID() ContractID {
 }
 
 func (q *AccountBalanceQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the query with the provided client
 func (q *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *AccountBalanceQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountBalance, error) {
 	if client == nil {
 		return AccountBalance{}, errNoClientProvided
 	}
@@ -84,7 +90,7 @@ func (q *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
 		return AccountBalance{}, err
 	}
 
-	resp, err := q.Query.execute(client, q)
+	resp, err := q.Query.execute(ctx, client, q)
 	if err != nil {
 		return AccountBalance{}, err
 	}
@@ -138,6 +144,12 @@ func (q *AccountBalanceQuery) SetLogLevel(level LogLevel) *AccountBalanceQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *AccountBalanceQuery) SetRetryPolicy(policy RetryPolicy) *AccountBalanceQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *AccountBalanceQuery) getMethod(channel *_Channel) _Method {
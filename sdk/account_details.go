@@ -0,0 +1,238 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// AccountDetails is the full detail of an account returned from an AccountDetailsQuery,
+// including the allowances the account has granted.
+type AccountDetails struct {
+	AccountID         AccountID
+	ContractAccountID string
+	IsDeleted         bool
+	// Deprecated
+	ProxyAccountID                AccountID
+	ProxyReceived                 Hbar
+	Key                           Key
+	Balance                       Hbar
+	ReceiverSigRequired           bool
+	ExpirationTime                time.Time
+	AutoRenewPeriod               time.Duration
+	TokenRelationships            []*TokenRelationship
+	AccountMemo                   string
+	OwnedNfts                     int64
+	MaxAutomaticTokenAssociations int32
+	AliasKey                      *PublicKey
+	LedgerID                      LedgerID
+	HbarAllowances                []HbarAllowance
+	NftAllowances                 []TokenNftAllowance
+	TokenAllowances               []TokenAllowance
+}
+
+func _AccountDetailsFromProtobuf(pb *services.GetAccountDetailsResponse_AccountDetails) (AccountDetails, error) {
+	if pb == nil {
+		return AccountDetails{}, errParameterNull
+	}
+
+	key, err := _KeyFromProtobuf(pb.Key)
+	if err != nil {
+		return AccountDetails{}, err
+	}
+
+	accountID := AccountID{}
+	if pb.AccountId != nil {
+		accountID = *_AccountIDFromProtobuf(pb.AccountId)
+	}
+
+	proxyAccountID := AccountID{}
+	if pb.ProxyAccountId != nil { // nolint
+		proxyAccountID = *_AccountIDFromProtobuf(pb.ProxyAccountId) // nolint
+	}
+
+	var alias *PublicKey
+	if len(pb.Alias) != 0 {
+		pbKey := services.Key{}
+		_ = protobuf.Unmarshal(pb.Alias, &pbKey)
+		initialKey, _ := _KeyFromProtobuf(&pbKey)
+		switch t2 := initialKey.(type) { //nolint
+		case PublicKey:
+			alias = &t2
+		}
+	}
+
+	var tokenRelationships []*TokenRelationship
+	if pb.TokenRelationships != nil {
+		tokenRelationships = _TokenRelationshipsFromProtobuf(pb.TokenRelationships)
+	}
+
+	// granted allowances don't repeat the owner, which is always the account itself
+	hbarAllowances := make([]HbarAllowance, 0, len(pb.GrantedCryptoAllowances))
+	for _, allowance := range pb.GrantedCryptoAllowances {
+		hbarAllowance := HbarAllowance{
+			OwnerAccountID: &accountID,
+			Amount:         allowance.Amount,
+		}
+		if allowance.Spender != nil {
+			hbarAllowance.SpenderAccountID = _AccountIDFromProtobuf(allowance.Spender)
+		}
+		hbarAllowances = append(hbarAllowances, hbarAllowance)
+	}
+
+	nftAllowances := make([]TokenNftAllowance, 0, len(pb.GrantedNftAllowances))
+	for _, allowance := range pb.GrantedNftAllowances {
+		nftAllowance := TokenNftAllowance{
+			OwnerAccountID: &accountID,
+			SerialNumbers:  []int64{},
+			AllSerials:     true,
+		}
+		if allowance.TokenId != nil {
+			nftAllowance.TokenID = _TokenIDFromProtobuf(allowance.TokenId)
+		}
+		if allowance.Spender != nil {
+			nftAllowance.SpenderAccountID = _AccountIDFromProtobuf(allowance.Spender)
+		}
+		nftAllowances = append(nftAllowances, nftAllowance)
+	}
+
+	tokenAllowances := make([]TokenAllowance, 0, len(pb.GrantedTokenAllowances))
+	for _, allowance := range pb.GrantedTokenAllowances {
+		tokenAllowance := TokenAllowance{
+			OwnerAccountID: &accountID,
+			Amount:         allowance.Amount,
+		}
+		if allowance.TokenId != nil {
+			tokenAllowance.TokenID = _TokenIDFromProtobuf(allowance.TokenId)
+		}
+		if allowance.Spender != nil {
+			tokenAllowance.SpenderAccountID = _AccountIDFromProtobuf(allowance.Spender)
+		}
+		tokenAllowances = append(tokenAllowances, tokenAllowance)
+	}
+
+	return AccountDetails{
+		AccountID:                     accountID,
+		ContractAccountID:             pb.ContractAccountId,
+		IsDeleted:                     pb.Deleted,
+		ProxyAccountID:                proxyAccountID,
+		ProxyReceived:                 HbarFromTinybar(pb.ProxyReceived),
+		Key:                           key,
+		Balance:                       HbarFromTinybar(int64(pb.Balance)),
+		ReceiverSigRequired:           pb.ReceiverSigRequired,
+		ExpirationTime:                _TimeFromProtobuf(pb.ExpirationTime),
+		AutoRenewPeriod:               _DurationFromProtobuf(pb.AutoRenewPeriod),
+		TokenRelationships:            tokenRelationships,
+		AccountMemo:                   pb.Memo,
+		OwnedNfts:                     pb.OwnedNfts,
+		MaxAutomaticTokenAssociations: pb.MaxAutomaticTokenAssociations,
+		AliasKey:                      alias,
+		LedgerID:                      LedgerID{pb.LedgerId},
+		HbarAllowances:                hbarAllowances,
+		NftAllowances:                 nftAllowances,
+		TokenAllowances:               tokenAllowances,
+	}, nil
+}
+
+func (details AccountDetails) _ToProtobuf() *services.GetAccountDetailsResponse_AccountDetails {
+	var alias []byte
+	if details.AliasKey != nil {
+		alias, _ = protobuf.Marshal(details.AliasKey._ToProtoKey())
+	}
+
+	tokenRelationships := make([]*services.TokenRelationship, len(details.TokenRelationships))
+	for i, relationship := range details.TokenRelationships {
+		tokenRelationships[i] = relationship._ToProtobuf()
+	}
+
+	hbarAllowances := make([]*services.GrantedCryptoAllowance, len(details.HbarAllowances))
+	for i, allowance := range details.HbarAllowances {
+		hbarAllowances[i] = &services.GrantedCryptoAllowance{
+			Amount: allowance.Amount,
+		}
+		if allowance.SpenderAccountID != nil {
+			hbarAllowances[i].Spender = allowance.SpenderAccountID._ToProtobuf()
+		}
+	}
+
+	nftAllowances := make([]*services.GrantedNftAllowance, len(details.NftAllowances))
+	for i, allowance := range details.NftAllowances {
+		nftAllowances[i] = &services.GrantedNftAllowance{}
+		if allowance.TokenID != nil {
+			nftAllowances[i].TokenId = allowance.TokenID._ToProtobuf()
+		}
+		if allowance.SpenderAccountID != nil {
+			nftAllowances[i].Spender = allowance.SpenderAccountID._ToProtobuf()
+		}
+	}
+
+	tokenAllowances := make([]*services.GrantedTokenAllowance, len(details.TokenAllowances))
+	for i, allowance := range details.TokenAllowances {
+		tokenAllowances[i] = &services.GrantedTokenAllowance{
+			Amount: allowance.Amount,
+		}
+		if allowance.TokenID != nil {
+			tokenAllowances[i].TokenId = allowance.TokenID._ToProtobuf()
+		}
+		if allowance.SpenderAccountID != nil {
+			tokenAllowances[i].Spender = allowance.SpenderAccountID._ToProtobuf()
+		}
+	}
+
+	body := &services.GetAccountDetailsResponse_AccountDetails{
+		AccountId:                     details.AccountID._ToProtobuf(),
+		ContractAccountId:             details.ContractAccountID,
+		Deleted:                       details.IsDeleted,
+		ProxyReceived:                 details.ProxyReceived.tinybar,
+		Balance:                       uint64(details.Balance.tinybar),
+		ReceiverSigRequired:           details.ReceiverSigRequired,
+		ExpirationTime:                _TimeToProtobuf(details.ExpirationTime),
+		AutoRenewPeriod:               _DurationToProtobuf(details.AutoRenewPeriod),
+		TokenRelationships:            tokenRelationships,
+		Memo:                          details.AccountMemo,
+		OwnedNfts:                     details.OwnedNfts,
+		MaxAutomaticTokenAssociations: details.MaxAutomaticTokenAssociations,
+		Alias:                         alias,
+		LedgerId:                      details.LedgerID.ToBytes(),
+		GrantedCryptoAllowances:       hbarAllowances,
+		GrantedNftAllowances:          nftAllowances,
+		GrantedTokenAllowances:        tokenAllowances,
+	}
+
+	if details.Key != nil {
+		body.Key = details.Key._ToProtoKey()
+	}
+
+	if details.ProxyAccountID != (AccountID{}) {
+		body.ProxyAccountId = details.ProxyAccountID._ToProtobuf() // nolint
+	}
+
+	return body
+}
+
+// ToBytes returns the serialized bytes of an AccountDetails
+func (details AccountDetails) ToBytes() []byte {
+	data, err := protobuf.Marshal(details._ToProtobuf())
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+// AccountDetailsFromBytes returns an AccountDetails from byte array
+func AccountDetailsFromBytes(data []byte) (AccountDetails, error) {
+	if data == nil {
+		return AccountDetails{}, errByteArrayNull
+	}
+	pb := services.GetAccountDetailsResponse_AccountDetails{}
+	err := protobuf.Unmarshal(data, &pb)
+	if err != nil {
+		return AccountDetails{}, err
+	}
+
+	return _AccountDetailsFromProtobuf(&pb)
+}
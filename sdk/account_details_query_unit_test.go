@@ -0,0 +1,134 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitAccountDetailsQueryValidate(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	require.NoError(t, err)
+	client.SetAutoValidateChecksums(true)
+	accountID, err := AccountIDFromString("0.0.123-esxsf")
+	require.NoError(t, err)
+
+	detailsQuery := NewAccountDetailsQuery().
+		SetAccountID(accountID)
+
+	err = detailsQuery.validateNetworkOnIDs(client)
+	require.NoError(t, err)
+
+	accountID, err = AccountIDFromString("0.0.123-rmkykd")
+	require.NoError(t, err)
+
+	err = detailsQuery.SetAccountID(accountID).validateNetworkOnIDs(client)
+	require.Error(t, err)
+}
+
+func TestUnitAccountDetailsQuerySetNothing(t *testing.T) {
+	t.Parallel()
+
+	query := NewAccountDetailsQuery()
+
+	require.Equal(t, AccountID{}, query.GetAccountID())
+	require.Equal(t, []AccountID{}, query.GetNodeAccountIDs())
+	require.Equal(t, 10, query.GetMaxRetryCount())
+	require.Equal(t, Hbar{}, query.GetQueryPayment())
+}
+
+func _MockAccountDetailsProtobuf() *services.GetAccountDetailsResponse_AccountDetails {
+	key, _ := PrivateKeyFromStringEd25519("302e020100300506032b657004220420db484b828e64b2d8f12ce3c0a0e93a0b8cce7af1bb8f39c97732394482538e10")
+
+	return &services.GetAccountDetailsResponse_AccountDetails{
+		AccountId:                     &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1234}},
+		ContractAccountId:             "00000000000000000000000000000000000004d2",
+		Key:                           key.PublicKey()._ToProtoKey(),
+		Balance:                       500,
+		ExpirationTime:                &services.Timestamp{Seconds: 1700000000},
+		AutoRenewPeriod:               &services.Duration{Seconds: 7776000},
+		Memo:                          "details",
+		OwnedNfts:                     2,
+		MaxAutomaticTokenAssociations: -1,
+		LedgerId:                      NewLedgerIDTestnet().ToBytes(),
+		GrantedCryptoAllowances: []*services.GrantedCryptoAllowance{
+			{Spender: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 10}}, Amount: 100},
+		},
+		GrantedNftAllowances: []*services.GrantedNftAllowance{
+			{TokenId: &services.TokenID{TokenNum: 5}, Spender: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 11}}},
+		},
+		GrantedTokenAllowances: []*services.GrantedTokenAllowance{
+			{TokenId: &services.TokenID{TokenNum: 6}, Spender: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 12}}, Amount: 50},
+		},
+	}
+}
+
+func TestUnitAccountDetailsQueryMock(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_AccountDetails{
+				AccountDetails: &services.GetAccountDetailsResponse{
+					Header:         &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountDetails: _MockAccountDetailsProtobuf(),
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	details, err := NewAccountDetailsQuery().
+		SetAccountID(AccountID{Account: 1234}).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetQueryPayment(NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	owner := AccountID{Account: 1234}
+	require.Equal(t, owner, details.AccountID)
+	require.Equal(t, HbarFromTinybar(500), details.Balance)
+	require.Equal(t, "details", details.AccountMemo)
+	require.Equal(t, time.Unix(1700000000, 0), details.ExpirationTime)
+	require.Equal(t, 90*24*time.Hour, details.AutoRenewPeriod)
+	require.Equal(t, int32(-1), details.MaxAutomaticTokenAssociations)
+	require.Equal(t, []HbarAllowance{NewHbarAllowance(owner, AccountID{Account: 10}, 100)}, details.HbarAllowances)
+	require.Equal(t, []TokenAllowance{NewTokenAllowance(TokenID{Token: 6}, owner, AccountID{Account: 12}, 50)}, details.TokenAllowances)
+	require.Len(t, details.NftAllowances, 1)
+	require.Equal(t, TokenID{Token: 5}, *details.NftAllowances[0].TokenID)
+	require.Equal(t, AccountID{Account: 11}, *details.NftAllowances[0].SpenderAccountID)
+	require.Equal(t, owner, *details.NftAllowances[0].OwnerAccountID)
+	require.True(t, details.NftAllowances[0].AllSerials)
+}
+
+func TestUnitAccountDetailsFromBytes(t *testing.T) {
+	t.Parallel()
+
+	details, err := _AccountDetailsFromProtobuf(_MockAccountDetailsProtobuf())
+	require.NoError(t, err)
+
+	result, err := AccountDetailsFromBytes(details.ToBytes())
+	require.NoError(t, err)
+	require.Equal(t, details.AccountID, result.AccountID)
+	require.Equal(t, details.Key.String(), result.Key.String())
+	require.Equal(t, details.ExpirationTime, result.ExpirationTime)
+	require.Equal(t, details.LedgerID, result.LedgerID)
+	require.Equal(t, details.HbarAllowances, result.HbarAllowances)
+	require.Equal(t, details.TokenAllowances, result.TokenAllowances)
+	require.Equal(t, details.NftAllowances, result.NftAllowances)
+
+	_, err = AccountDetailsFromBytes(nil)
+	require.Error(t, err)
+}
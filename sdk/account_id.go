@@ -1,10 +1,9 @@
 package hiero
 
 import (
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 
@@ -337,62 +336,40 @@ const (
 	EvmAddress
 )
 
-func (id *AccountID) _MirrorNodeRequest(client *Client, populateType string) (map[string]interface{}, error) {
-	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return nil, errors.New("mirror node is not set")
-	}
-
-	mirrorUrl := client.GetMirrorNetwork()[0]
-	index := strings.Index(mirrorUrl, ":")
-	if index == -1 {
-		return nil, errors.New("invalid mirrorUrl format")
-	}
-	mirrorUrl = mirrorUrl[:index]
-
-	var url string
-	protocol := "https"
-	port := ""
-
-	if client.GetLedgerID() == nil {
-		protocol = "http"
-		port = ":5551"
+func (id *AccountID) _MirrorNodeRequest(ctx context.Context, client *Client, populateType string) (MirrorNodeAccount, error) {
+	if client == nil {
+		return MirrorNodeAccount{}, errNoClientProvided
 	}
 
 	if populateType == "account" {
-		url = fmt.Sprintf("%s://%s%s/api/v1/accounts/%s", protocol, mirrorUrl, port, hex.EncodeToString(*id.AliasEvmAddress))
-	} else {
-		url = fmt.Sprintf("%s://%s%s/api/v1/accounts/%s", protocol, mirrorUrl, port, id.String())
-	}
-
-	resp, err := http.Get(url) // #nosec
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+		if id.AliasEvmAddress == nil {
+			return MirrorNodeAccount{}, errors.New("evm address is not set")
+		}
+		return client.GetMirrorNodeClient().GetAccount(ctx, hex.EncodeToString(*id.AliasEvmAddress))
 	}
 
-	return result, nil
+	return client.GetMirrorNodeClient().GetAccount(ctx, id.String())
 }
 
 // PopulateAccount gets the actual `Account` field of the `AccountId` from the Mirror Node.
 // Should be used after generating `AccountId.FromEvmAddress()` because it sets the `Account` field to `0`
 // automatically since there is no connection between the `Account` and the `evmAddress`
 func (id *AccountID) PopulateAccount(client *Client) error {
-	result, err := id._MirrorNodeRequest(client, "account")
+	return id.PopulateAccountWithContext(context.Background(), client)
+}
+
+// PopulateAccountWithContext is PopulateAccount, stopping early when ctx is done.
+func (id *AccountID) PopulateAccountWithContext(ctx context.Context, client *Client) error {
+	result, err := id._MirrorNodeRequest(ctx, client, "account")
 	if err != nil {
 		return err
 	}
 
-	mirrorAccountId, ok := result["account"].(string)
-	if !ok {
+	if result.Account == "" {
 		return errors.New("unexpected response format")
 	}
 
-	numStr := mirrorAccountId[strings.LastIndex(mirrorAccountId, ".")+1:]
+	numStr := result.Account[strings.LastIndex(result.Account, ".")+1:]
 	num, err := strconv.ParseInt(numStr, 10, 64)
 	if err != nil {
 		return err
@@ -403,17 +380,21 @@ func (id *AccountID) PopulateAccount(client *Client) error {
 
 // PopulateEvmAddress gets the actual `AliasEvmAddress` field of the `AccountId` from the Mirror Node.
 func (id *AccountID) PopulateEvmAddress(client *Client) error {
-	result, err := id._MirrorNodeRequest(client, "evmAddress")
+	return id.PopulateEvmAddressWithContext(context.Background(), client)
+}
+
+// PopulateEvmAddressWithContext is PopulateEvmAddress, stopping early when ctx is done.
+func (id *AccountID) PopulateEvmAddressWithContext(ctx context.Context, client *Client) error {
+	result, err := id._MirrorNodeRequest(ctx, client, "evmAddress")
 	if err != nil {
 		return err
 	}
 
-	mirrorEvmAddress, ok := result["evm_address"].(string)
-	if !ok {
+	if result.EvmAddress == "" {
 		return errors.New("unexpected response format")
 	}
 
-	mirrorEvmAddress = strings.TrimPrefix(mirrorEvmAddress, "0x")
+	mirrorEvmAddress := strings.TrimPrefix(result.EvmAddress, "0x")
 	asd, err := hex.DecodeString(mirrorEvmAddress)
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -27,12 +28,17 @@ func NewAccountInfoQuery() *AccountInfoQuery {
 }
 
 func (q *AccountInfoQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
-	resp, err := q.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *AccountInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountInfo, error) {
+	resp, err := q.execute(ctx, client, q)
 
 	if err != nil {
 		return AccountInfo{}, err
@@ -109,6 +115,12 @@ func (q *AccountInfoQuery) SetLogLevel(level LogLevel) *AccountInfoQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *AccountInfoQuery) SetRetryPolicy(policy RetryPolicy) *AccountInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *AccountInfoQuery) getMethod(channel *_Channel) _Method {
@@ -0,0 +1,85 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+)
+
+// AccountNftInfosQuery lists the NFTs owned by an account in the index range
+// [start, end), ordered by token ID and serial number.
+type AccountNftInfosQuery struct {
+	accountID *AccountID
+	start     int64
+	end       int64
+}
+
+// NewAccountNftInfosQuery creates an AccountNftInfosQuery which lists the NFTs
+// owned by an account. By default every NFT owned by the account is returned.
+func NewAccountNftInfosQuery() *AccountNftInfosQuery {
+	return &AccountNftInfosQuery{}
+}
+
+// SetAccountID sets the ID of the account whose NFTs are listed.
+func (q *AccountNftInfosQuery) SetAccountID(accountID AccountID) *AccountNftInfosQuery {
+	q.accountID = &accountID
+	return q
+}
+
+// GetAccountID returns the ID of the account whose NFTs are listed.
+func (q *AccountNftInfosQuery) GetAccountID() AccountID {
+	if q.accountID == nil {
+		return AccountID{}
+	}
+
+	return *q.accountID
+}
+
+// SetStart sets the index (inclusive) of the first NFT to return.
+func (q *AccountNftInfosQuery) SetStart(start int64) *AccountNftInfosQuery {
+	q.start = start
+	return q
+}
+
+// GetStart returns the index (inclusive) of the first NFT to return.
+func (q *AccountNftInfosQuery) GetStart() int64 {
+	return q.start
+}
+
+// SetEnd sets the index (exclusive) of the last NFT to return. 0 returns every NFT after start.
+func (q *AccountNftInfosQuery) SetEnd(end int64) *AccountNftInfosQuery {
+	q.end = end
+	return q
+}
+
+// GetEnd returns the index (exclusive) of the last NFT to return.
+func (q *AccountNftInfosQuery) GetEnd() int64 {
+	return q.end
+}
+
+// Execute executes the query using the client's mirror node and returns the NFTs in the range.
+func (q *AccountNftInfosQuery) Execute(client *Client) ([]TokenNftInfo, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the query using the client's mirror node, stopping when ctx is done.
+func (q *AccountNftInfosQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TokenNftInfo, error) {
+	if client == nil {
+		return []TokenNftInfo{}, errNoClientProvided
+	}
+
+	if q.accountID == nil {
+		return []TokenNftInfo{}, errors.New("accountID must be set")
+	}
+
+	if client.autoValidateChecksums {
+		if err := q.accountID.Validate(client); err != nil {
+			return []TokenNftInfo{}, err
+		}
+	}
+
+	return _NftInfosFromMirrorNode(ctx, client, q.start, q.end, func(params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeNft], error) {
+		return client.GetMirrorNodeClient().GetAccountNfts(ctx, q.accountID.String(), params)
+	})
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -49,12 +50,17 @@ func (q *AccountRecordsQuery) GetAccountID() AccountID {
 }
 
 func (q *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *AccountRecordsQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TransactionRecord, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 	records := make([]TransactionRecord, 0)
 
 	if err != nil {
@@ -116,6 +122,12 @@ func (q *AccountRecordsQuery) SetLogLevel(level LogLevel) *AccountRecordsQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *AccountRecordsQuery) SetRetryPolicy(policy RetryPolicy) *AccountRecordsQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *AccountRecordsQuery) getMethod(channel *_Channel) _Method {
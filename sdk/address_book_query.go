@@ -92,6 +92,12 @@ func (q *AddressBookQuery) build() *mirror.AddressBookQuery {
 
 // Execute executes the Query with the provided client
 func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping the stream and any retry waits when
+// userCtx is done.
+func (q *AddressBookQuery) ExecuteWithContext(userCtx context.Context, client *Client) (NodeAddressBook, error) {
 	var cancel func()
 	var ctx context.Context
 	var subClientError error
@@ -100,7 +106,14 @@ func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
 		return NodeAddressBook{}, err
 	}
 
+	// The stream has to stop when either the caller's context or the client's network update context ends
+	parentCtx, cancelParent := context.WithCancel(userCtx)
+	defer cancelParent()
+	stop := context.AfterFunc(client.networkUpdateContext, cancelParent)
+	defer stop()
+
 	pb := q.build()
+	limit := pb.Limit
 
 	messages := make([]*services.NodeAddress, 0)
 
@@ -123,18 +136,51 @@ func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
 			if err != nil {
 				cancel()
 
+				if userCtx.Err() != nil {
+					subClientError = ErrExecutionInterrupted{
+						Attempt:     int64(q.attempt) + 1,
+						MaxAttempts: int(q.maxAttempts),
+						Cause:       userCtx.Err(),
+						LastError:   err,
+					}
+					break
+				}
+
 				if grpcErr, ok := status.FromError(err); ok { // nolint
+					if parentCtx.Err() == nil {
+						// The stream failed because of the mirror node, which is backed off like a consensus node
+						// after a failed call
+						client.mirrorNetwork._IncreaseBackoff(mirrorNode)
+					}
+
 					if q.attempt < q.maxAttempts {
 						subClient = nil
 
+						// The address book is queried again from its start, on another mirror node when there is one
+						messages = messages[:0]
+						pb.Limit = limit
+						if next, err := client.mirrorNetwork._GetNextMirrorNodeAfter(mirrorNode); err == nil {
+							mirrorNode = next
+						}
+						if channel, err = mirrorNode._GetNetworkServiceClient(); err != nil {
+							subClientError = err
+							break
+						}
+
 						delay := math.Min(250.0*math.Pow(2.0, float64(q.attempt)), 8000)
-						time.Sleep(time.Duration(delay) * time.Millisecond)
+						timer := time.NewTimer(time.Duration(delay) * time.Millisecond)
+						select {
+						case <-parentCtx.Done():
+							timer.Stop()
+						case <-timer.C:
+						}
 						q.attempt++
 					} else {
 						subClientError = grpcErr.Err()
 						break
 					}
 				} else if err == io.EOF {
+					mirrorNode._DecreaseBackoff()
 					break
 				} else {
 					subClientError = err
@@ -143,7 +189,7 @@ func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
 			}
 
 			if subClient == nil {
-				ctx, cancel = context.WithCancel(client.networkUpdateContext)
+				ctx, cancel = context.WithCancel(parentCtx)
 
 				subClient, err = (*channel).GetNodes(ctx, pb)
 				if err != nil {
@@ -10,7 +10,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
+
+	"google.golang.org/grpc"
+	"gopkg.in/yaml.v3"
 )
 
 //go:embed addressbook/mainnet.pb
@@ -35,9 +42,13 @@ type Client struct {
 
 	network                         _Network
 	mirrorNetwork                   *_MirrorNetwork
+	mirrorNodeClient                *MirrorNodeClient
 	autoValidateChecksums           bool
 	defaultRegenerateTransactionIDs bool
 	maxAttempts                     *int
+	retryPolicy                     RetryPolicy
+	executionObserver               ExecutionObserver
+	ethereumNonceManager            *EthereumNonceManager
 
 	maxBackoff time.Duration
 	minBackoff time.Duration
@@ -182,6 +193,7 @@ func _NewClient(network _Network, mirrorNetwork []string, ledgerId *LedgerID, sh
 		realm:                           realm,
 	}
 
+	client.mirrorNodeClient = _NewMirrorNodeClient(&client)
 	client.SetMirrorNetwork(mirrorNetwork)
 	if ledgerId != nil {
 		client.SetLedgerID(*ledgerId)
@@ -260,39 +272,79 @@ func ClientForName(name string) (*Client, error) {
 	}
 }
 
-type _ConfigOperator struct {
-	AccountID  string `json:"accountId"`
-	PrivateKey string `json:"privateKey"`
-}
-
-// TODO: Implement complete spec: https://gitlab.com/launchbadge/hedera/sdk/python/-/issues/45
-type _ClientConfig struct {
-	Network       interface{}      `json:"network"`
-	MirrorNetwork interface{}      `json:"mirrorNetwork"`
-	Shard         uint64           `json:"shard"`
-	Realm         uint64           `json:"realm"`
-	Operator      *_ConfigOperator `json:"operator"`
-}
-
 // ClientFromConfig takes in the byte slice representation of a JSON string or
 // document and returns Client based on the configuration.
+//
+// The configuration has the fields
+//
+//	network                          a network name, or a map of node addresses to node account IDs
+//	mirrorNetwork                    a network name, or a list of mirror node addresses or of objects with the
+//	                                 "grpc" address and "rest" URL of a mirror node, as in SetMirrorNetworkEndpoints
+//	shard, realm                     the shard and realm of the network
+//	operator.accountId               the account ID of the operator
+//	operator.privateKey              the private key of the operator, DER or raw hex encoded, or PEM encoded
+//	operator.keystore                the keystore of the operator key, instead of operator.privateKey
+//	operator.passphrase              the passphrase of an encrypted PEM key or of the keystore
+//	maxAttempts                      SetMaxAttempts
+//	maxNodeAttempts                  SetMaxNodeAttempts
+//	maxNodesPerTransaction           SetMaxNodesPerTransaction
+//	minBackoff, maxBackoff           SetMinBackoff and SetMaxBackoff
+//	nodeMinBackoff, nodeMaxBackoff   SetNodeMinBackoff and SetNodeMaxBackoff
+//	nodeMinReadmitPeriod             SetNodeMinReadmitPeriod
+//	nodeMaxReadmitPeriod             SetNodeMaxReadmitPeriod
+//	requestTimeout                   SetRequestTimeout
+//	networkUpdatePeriod              SetNetworkUpdatePeriod
+//	defaultMaxTransactionFee         SetDefaultMaxTransactionFee
+//	defaultMaxQueryPayment           SetDefaultMaxQueryPayment
+//	transportSecurity                SetTransportSecurity
+//	verifyCertificate                SetCertificateVerification
+//	autoValidateChecksums            SetAutoValidateChecksums
+//	defaultRegenerateTransactionIDs  SetDefaultRegenerateTransactionIDs
+//	logLevel                         SetLogLevel
+//
+// Durations are strings like "250ms" or "8s", or numbers of milliseconds. Hbar amounts are strings like "2 ℏ" or
+// "100000 tℏ", or numbers of hbars.
+//
+// Every field is overridden by the environment variable named after it in upper snake case with the HIERO_ prefix,
+// like HIERO_MAX_ATTEMPTS or HIERO_OPERATOR_PRIVATE_KEY, when it is set. HIERO_NETWORK is a network name or a comma
+// separated list of address=accountId pairs, and HIERO_MIRROR_NETWORK a network name or a comma separated list of
+// addresses or address=restUrl pairs. An invalid field is reported with an ErrInvalidClientConfig naming it.
 func ClientFromConfig(jsonBytes []byte) (*Client, error) {
-	return clientFromConfig(jsonBytes, true)
+	return clientFromConfig(jsonBytes, json.Unmarshal, true)
+}
+
+// ClientFromConfigYAML takes in a YAML document and returns Client based on the configuration, with the fields
+// of ClientFromConfig.
+func ClientFromConfigYAML(yamlBytes []byte) (*Client, error) {
+	return clientFromConfig(yamlBytes, yaml.Unmarshal, true)
 }
 
 // ClientFromConfigWithoutScheduleNetworkUpdate does not schedule network update
 // the user has to call SetNetworkUpdatePeriod manually
 func ClientFromConfigWithoutScheduleNetworkUpdate(jsonBytes []byte) (*Client, error) {
-	client, err := clientFromConfig(jsonBytes, false)
+	client, err := clientFromConfig(jsonBytes, json.Unmarshal, false)
+	if err != nil {
+		return client, err
+	}
 	client.SetNetworkUpdatePeriod(0)
-	return client, err
+	return client, nil
 }
 
-func clientFromConfig(jsonBytes []byte, shouldScheduleNetworkUpdate bool) (*Client, error) {
+func clientFromConfig(configBytes []byte, unmarshal func([]byte, interface{}) error, shouldScheduleNetworkUpdate bool) (*Client, error) {
 	var clientConfig _ClientConfig
 	var client *Client
 
-	err := json.Unmarshal(jsonBytes, &clientConfig)
+	err := unmarshal(configBytes, &clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	clientConfig._ApplyEnvironment(os.LookupEnv)
+
+	shard, err := clientConfig._Uint("shard", clientConfig.Shard)
+	if err != nil {
+		return nil, err
+	}
+	realm, err := clientConfig._Uint("realm", clientConfig.Realm)
 	if err != nil {
 		return nil, err
 	}
@@ -327,71 +379,78 @@ func clientFromConfig(jsonBytes []byte, shouldScheduleNetworkUpdate bool) (*Clie
 				network = *_NetworkForPreviewnet(previewnetNodes._ToMap())
 			case string(NetworkNameTestnet):
 				network = *_NetworkForTestnet(testnetNodes._ToMap())
+			default:
+				return nil, clientConfig._Error("network", fmt.Errorf("%q is not recognized as a valid network name", net))
 			}
 		}
 	default:
 		return client, errors.New("network is expected to be map of string to string, or string")
 	}
 
+	var mirrorEndpoints []MirrorNodeEndpoint
+	var mirrorRestURLs bool
 	switch mirror := clientConfig.MirrorNetwork.(type) {
 	case []interface{}:
 		arr := make([]string, len(mirror))
 		for i, inter := range mirror {
-			switch str := inter.(type) {
+			switch value := inter.(type) {
 			case string:
-				arr[i] = str
+				arr[i] = value
+				mirrorEndpoints = append(mirrorEndpoints, MirrorNodeEndpoint{GrpcAddress: value})
+			case map[string]interface{}:
+				grpcAddress, grpcOk := value["grpc"].(string)
+				restURL, restOk := value["rest"].(string)
+				if !grpcOk || (value["rest"] != nil && !restOk) {
+					return nil, clientConfig._Error("mirrorNetwork", errors.New(`expected an address or an object with "grpc" and "rest" strings`))
+				}
+				arr[i] = grpcAddress
+				mirrorRestURLs = mirrorRestURLs || restURL != ""
+				mirrorEndpoints = append(mirrorEndpoints, MirrorNodeEndpoint{GrpcAddress: grpcAddress, RestURL: restURL})
 			default:
 				return client, errors.New("mirrorNetwork is expected to be either string or an array of strings")
 			}
 		}
-		client = _NewClient(network, arr, nil, shouldScheduleNetworkUpdate, clientConfig.Shard, clientConfig.Realm)
-	case string:
-		if len(mirror) > 0 {
-			switch mirror {
-			case string(NetworkNameMainnet):
-				client = _NewClient(network, mainnetMirror, NewLedgerIDMainnet(), shouldScheduleNetworkUpdate, clientConfig.Shard, clientConfig.Realm)
-			case string(NetworkNameTestnet):
-				client = _NewClient(network, testnetMirror, NewLedgerIDTestnet(), shouldScheduleNetworkUpdate, clientConfig.Shard, clientConfig.Realm)
-			case string(NetworkNamePreviewnet):
-				client = _NewClient(network, previewnetMirror, NewLedgerIDPreviewnet(), shouldScheduleNetworkUpdate, clientConfig.Shard, clientConfig.Realm)
+		client = _NewClient(network, arr, nil, shouldScheduleNetworkUpdate, shard, realm)
+		if mirrorRestURLs {
+			if err = client.SetMirrorNetworkEndpoints(mirrorEndpoints); err != nil {
+				return nil, clientConfig._Error("mirrorNetwork", err)
 			}
 		}
+	case string:
+		switch mirror {
+		case string(NetworkNameMainnet):
+			client = _NewClient(network, mainnetMirror, NewLedgerIDMainnet(), shouldScheduleNetworkUpdate, shard, realm)
+		case string(NetworkNameTestnet):
+			client = _NewClient(network, testnetMirror, NewLedgerIDTestnet(), shouldScheduleNetworkUpdate, shard, realm)
+		case string(NetworkNamePreviewnet):
+			client = _NewClient(network, previewnetMirror, NewLedgerIDPreviewnet(), shouldScheduleNetworkUpdate, shard, realm)
+		case "":
+			client = _NewClient(network, []string{}, nil, shouldScheduleNetworkUpdate, shard, realm)
+		default:
+			return nil, clientConfig._Error("mirrorNetwork", fmt.Errorf("%q is not recognized as a valid network name", mirror))
+		}
 	case nil:
-		client = _NewClient(network, []string{}, nil, true, clientConfig.Shard, clientConfig.Realm)
+		client = _NewClient(network, []string{}, nil, true, shard, realm)
 	default:
 		return client, errors.New("mirrorNetwork is expected to be a string, an array of strings or nil")
 	}
 
-	// if the _Operator is not provided, finish here
-	if clientConfig.Operator == nil {
-		return client, nil
-	}
-
-	operatorID, err := AccountIDFromString(clientConfig.Operator.AccountID)
-	if err != nil {
-		return client, err
-	}
-
-	operatorKey, err := PrivateKeyFromString(clientConfig.Operator.PrivateKey)
-
-	if err != nil {
-		return client, err
+	if err = clientConfig._ApplyOperator(client); err != nil {
+		_ = client.Close()
+		return nil, err
 	}
 
-	operator := _Operator{
-		accountID:  operatorID,
-		privateKey: &operatorKey,
-		publicKey:  operatorKey.PublicKey(),
-		signer:     operatorKey.Sign,
+	if err = clientConfig._ApplySettings(client); err != nil {
+		_ = client.Close()
+		return nil, err
 	}
 
-	client.operator = &operator
-
 	return client, nil
 }
 
 // ClientFromConfigFile takes a filename string representing the path to a JSON encoded
-// Client file and returns a Client based on the configuration.
+// Client file and returns a Client based on the configuration. Files with the .yaml or
+// .yml extension are read as YAML.
 func ClientFromConfigFile(filename string) (*Client, error) {
 	file, err := os.Open(filename)
 	if err != nil {
@@ -407,7 +466,12 @@ func ClientFromConfigFile(filename string) (*Client, error) {
 		return nil, err
 	}
 
-	return ClientFromConfig(configBytes)
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".yaml", ".yml":
+		return ClientFromConfigYAML(configBytes)
+	default:
+		return ClientFromConfig(configBytes)
+	}
 }
 
 // Close is used to disconnect the Client from the _Network
@@ -504,6 +568,50 @@ func (client *Client) GetMaxAttempts() int {
 	return *client.maxAttempts
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of transactions and queries are
+// retried, unless the transaction or query has its own. A nil policy restores the default behaviour.
+func (client *Client) SetRetryPolicy(policy RetryPolicy) *Client {
+	client.retryPolicy = policy
+	return client
+}
+
+// GetRetryPolicy returns the policy deciding whether failed attempts are retried, nil when the default behaviour is used.
+func (client *Client) GetRetryPolicy() RetryPolicy {
+	return client.retryPolicy
+}
+
+// SetNodeSelector sets the strategy choosing the healthy nodes transactions and queries without node account IDs are
+// sent to. A nil selector restores the default, the RandomNodeSelector.
+func (client *Client) SetNodeSelector(selector NodeSelector) *Client {
+	client.network._SetNodeSelector(selector)
+	return client
+}
+
+// GetNodeSelector returns the strategy choosing the healthy nodes transactions and queries are sent to.
+func (client *Client) GetNodeSelector() NodeSelector {
+	return client.network._GetNodeSelector()
+}
+
+// SetExecutionObserver sets the observer receiving the execution events of the transactions, queries
+// and topic subscriptions executed with this client. A nil observer disables the events.
+func (client *Client) SetExecutionObserver(observer ExecutionObserver) *Client {
+	client.executionObserver = observer
+	return client
+}
+
+// GetExecutionObserver returns the observer receiving the execution events, nil when none is set.
+func (client *Client) GetExecutionObserver() ExecutionObserver {
+	return client.executionObserver
+}
+
+func (client *Client) _GetExecutionObserver() ExecutionObserver {
+	if client == nil || client.executionObserver == nil {
+		return NoopExecutionObserver{}
+	}
+
+	return client.executionObserver
+}
+
 // SetMaxNodeAttempts sets the maximum number of times to attempt a transaction or query on a single node.
 func (client *Client) SetMaxNodeAttempts(max int) {
 	client.network._SetMaxNodeAttempts(max)
@@ -560,6 +668,90 @@ func (client *Client) GetMirrorNetwork() []string {
 	return client.mirrorNetwork._GetNetwork()
 }
 
+// SetMirrorNetworkEndpoints replaces the mirror nodes of the Client with nodes having separate gRPC and REST
+// endpoints. The gRPC endpoints serve the TopicMessageQuery and AddressBookQuery subscriptions and the REST ones the
+// requests of the MirrorNodeClient, such as MirrorNodeContractCallQuery. Requests fail over across all the mirror
+// nodes, which are backed off after failures like consensus nodes.
+func (client *Client) SetMirrorNetworkEndpoints(endpoints []MirrorNodeEndpoint) error {
+	return client.mirrorNetwork._SetEndpoints(endpoints)
+}
+
+// GetMirrorNetworkEndpoints returns the gRPC and REST endpoints of the mirror nodes, ordered by gRPC address. The
+// RestURL of a node is empty when it is derived from its gRPC address.
+func (client *Client) GetMirrorNetworkEndpoints() []MirrorNodeEndpoint {
+	return client.mirrorNetwork._GetEndpoints()
+}
+
+// GetMirrorNodeHealth returns a snapshot of the health of every mirror node, ordered by address.
+func (client *Client) GetMirrorNodeHealth() []NodeHealth {
+	return client.mirrorNetwork._GetNodeHealth()
+}
+
+// SetGrpcDialOptions sets extra gRPC dial options, such as proxies, per-RPC credentials, keepalive parameters or
+// message size limits, for the connections to consensus nodes and mirror nodes. They are applied after the options
+// of the SDK and take precedence over them. Interceptors are set with SetGrpcUnaryInterceptors and
+// SetGrpcStreamInterceptors, as an interceptor given here replaces the one of the SDK. The options apply to the
+// connections opened after the call, so they are best set before the first request.
+func (client *Client) SetGrpcDialOptions(options ...grpc.DialOption) *Client {
+	client.network.grpcOptions._SetDialOptions(options)
+	client.mirrorNetwork.grpcOptions._SetDialOptions(options)
+	return client
+}
+
+// GetGrpcDialOptions returns the extra gRPC dial options of the connections to consensus nodes and mirror nodes.
+func (client *Client) GetGrpcDialOptions() []grpc.DialOption {
+	return client.network.grpcOptions._GetDialOptions()
+}
+
+// SetGrpcUnaryInterceptors sets gRPC interceptors for the unary calls to consensus nodes and mirror nodes, chained
+// in order after the interceptor of the SDK adding the user agent. Like SetGrpcDialOptions, they apply to the
+// connections opened after the call.
+func (client *Client) SetGrpcUnaryInterceptors(interceptors ...grpc.UnaryClientInterceptor) *Client {
+	client.network.grpcOptions._SetUnaryInterceptors(interceptors)
+	client.mirrorNetwork.grpcOptions._SetUnaryInterceptors(interceptors)
+	return client
+}
+
+// GetGrpcUnaryInterceptors returns the gRPC interceptors of the unary calls to consensus nodes and mirror nodes.
+func (client *Client) GetGrpcUnaryInterceptors() []grpc.UnaryClientInterceptor {
+	return client.network.grpcOptions._GetUnaryInterceptors()
+}
+
+// SetGrpcStreamInterceptors sets gRPC interceptors for the streaming calls, such as the TopicMessageQuery and
+// AddressBookQuery subscriptions to mirror nodes, chained in order. Like SetGrpcDialOptions, they apply to the
+// connections opened after the call.
+func (client *Client) SetGrpcStreamInterceptors(interceptors ...grpc.StreamClientInterceptor) *Client {
+	client.network.grpcOptions._SetStreamInterceptors(interceptors)
+	client.mirrorNetwork.grpcOptions._SetStreamInterceptors(interceptors)
+	return client
+}
+
+// GetGrpcStreamInterceptors returns the gRPC interceptors of the streaming calls to consensus nodes and mirror nodes.
+func (client *Client) GetGrpcStreamInterceptors() []grpc.StreamClientInterceptor {
+	return client.network.grpcOptions._GetStreamInterceptors()
+}
+
+// SetEthereumNonceManager sets the manager handing out the Ethereum nonces of the senders of EthereumFlow
+// transactions built with SetEthereumTransactionBuilder. A nil manager leaves the nonces to the builders.
+func (client *Client) SetEthereumNonceManager(manager *EthereumNonceManager) *Client {
+	client.ethereumNonceManager = manager
+	return client
+}
+
+// GetEthereumNonceManager returns the manager handing out Ethereum nonces, nil when none is set.
+func (client *Client) GetEthereumNonceManager() *EthereumNonceManager {
+	return client.ethereumNonceManager
+}
+
+// GetMirrorNodeClient returns the typed mirror node REST client of the Client.
+func (client *Client) GetMirrorNodeClient() *MirrorNodeClient {
+	if client.mirrorNodeClient == nil {
+		client.mirrorNodeClient = _NewMirrorNodeClient(client)
+	}
+
+	return client.mirrorNodeClient
+}
+
 // GetShard returns the shard for the Client.
 func (client *Client) GetShard() uint64 {
 	return client.shard
@@ -739,6 +931,63 @@ func (client *Client) PingAll() {
 	}
 }
 
+// PingAllWithResults pings every node of the network concurrently, with a single attempt each, and returns the
+// result of every node ordered by account ID.
+func (client *Client) PingAllWithResults(ctx context.Context) []PingResult {
+	nodeAccountIDs := make([]AccountID, 0)
+	seen := make(map[AccountID]bool)
+	for _, nodeAccountID := range client.GetNetwork() {
+		if !seen[nodeAccountID] {
+			seen[nodeAccountID] = true
+			nodeAccountIDs = append(nodeAccountIDs, nodeAccountID)
+		}
+	}
+	sort.Slice(nodeAccountIDs, func(i, j int) bool {
+		return nodeAccountIDs[i].Compare(nodeAccountIDs[j]) < 0
+	})
+
+	results := make([]PingResult, len(nodeAccountIDs))
+	var wg sync.WaitGroup
+	for i, nodeAccountID := range nodeAccountIDs {
+		wg.Add(1)
+		go func(i int, nodeAccountID AccountID) {
+			defer wg.Done()
+
+			start := time.Now()
+			_, err := NewAccountBalanceQuery().
+				SetNodeAccountIDs([]AccountID{nodeAccountID}).
+				SetAccountID(client.GetOperatorAccountID()).
+				SetMaxRetry(1).
+				ExecuteWithContext(ctx, client)
+			results[i] = PingResult{NodeAccountID: nodeAccountID, RoundTrip: time.Since(start), Err: err}
+		}(i, nodeAccountID)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// GetNodeHealth returns a snapshot of the health of every consensus node of the network, ordered by account ID.
+func (client *Client) GetNodeHealth() []NodeHealth {
+	return client.network._GetNodeHealth()
+}
+
+// QuarantineNode takes the node out of use for the duration, as if its calls had been failing. Transactions and
+// queries without node account IDs are not sent to it until the duration passes or it is readmitted.
+func (client *Client) QuarantineNode(nodeAccountID AccountID, duration time.Duration) error {
+	if duration <= 0 {
+		return errors.New("the quarantine duration must be positive")
+	}
+
+	return client.network._QuarantineNode(nodeAccountID, duration)
+}
+
+// ReadmitNode puts the node back in use immediately, with its minimum backoff, after it was quarantined or backed
+// off after failed calls.
+func (client *Client) ReadmitNode(nodeAccountID AccountID) error {
+	return client.network._ReadmitNode(nodeAccountID)
+}
+
 // SetNetworkFromAddressBook replaces all nodes in this Client with the nodes in the Address Book.
 func (client *Client) SetNetworkFromAddressBook(addressBook NodeAddressBook) *Client {
 	client.network._SetNetworkFromAddressBook(addressBook)
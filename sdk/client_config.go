@@ -0,0 +1,499 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type _ConfigOperator struct {
+	AccountID  interface{} `json:"accountId" yaml:"accountId"`
+	PrivateKey interface{} `json:"privateKey" yaml:"privateKey"`
+	Keystore   interface{} `json:"keystore" yaml:"keystore"`
+	Passphrase interface{} `json:"passphrase" yaml:"passphrase"`
+}
+
+type _ClientConfig struct {
+	Network                         interface{}      `json:"network" yaml:"network"`
+	MirrorNetwork                   interface{}      `json:"mirrorNetwork" yaml:"mirrorNetwork"`
+	Shard                           interface{}      `json:"shard" yaml:"shard"`
+	Realm                           interface{}      `json:"realm" yaml:"realm"`
+	Operator                        *_ConfigOperator `json:"operator" yaml:"operator"`
+	MaxAttempts                     interface{}      `json:"maxAttempts" yaml:"maxAttempts"`
+	MaxNodeAttempts                 interface{}      `json:"maxNodeAttempts" yaml:"maxNodeAttempts"`
+	MaxNodesPerTransaction          interface{}      `json:"maxNodesPerTransaction" yaml:"maxNodesPerTransaction"`
+	MinBackoff                      interface{}      `json:"minBackoff" yaml:"minBackoff"`
+	MaxBackoff                      interface{}      `json:"maxBackoff" yaml:"maxBackoff"`
+	NodeMinBackoff                  interface{}      `json:"nodeMinBackoff" yaml:"nodeMinBackoff"`
+	NodeMaxBackoff                  interface{}      `json:"nodeMaxBackoff" yaml:"nodeMaxBackoff"`
+	NodeMinReadmitPeriod            interface{}      `json:"nodeMinReadmitPeriod" yaml:"nodeMinReadmitPeriod"`
+	NodeMaxReadmitPeriod            interface{}      `json:"nodeMaxReadmitPeriod" yaml:"nodeMaxReadmitPeriod"`
+	RequestTimeout                  interface{}      `json:"requestTimeout" yaml:"requestTimeout"`
+	NetworkUpdatePeriod             interface{}      `json:"networkUpdatePeriod" yaml:"networkUpdatePeriod"`
+	DefaultMaxTransactionFee        interface{}      `json:"defaultMaxTransactionFee" yaml:"defaultMaxTransactionFee"`
+	DefaultMaxQueryPayment          interface{}      `json:"defaultMaxQueryPayment" yaml:"defaultMaxQueryPayment"`
+	TransportSecurity               interface{}      `json:"transportSecurity" yaml:"transportSecurity"`
+	VerifyCertificate               interface{}      `json:"verifyCertificate" yaml:"verifyCertificate"`
+	AutoValidateChecksums           interface{}      `json:"autoValidateChecksums" yaml:"autoValidateChecksums"`
+	DefaultRegenerateTransactionIDs interface{}      `json:"defaultRegenerateTransactionIDs" yaml:"defaultRegenerateTransactionIDs"`
+	LogLevel                        interface{}      `json:"logLevel" yaml:"logLevel"`
+
+	// envVars are the environment variables which overrode fields, by field name
+	envVars map[string]string
+}
+
+// _ClientConfigField is a field of the client configuration which can be overridden by an environment variable
+type _ClientConfigField struct {
+	name   string
+	envVar string
+	value  func(config *_ClientConfig) *interface{}
+}
+
+var _ClientConfigFields = []_ClientConfigField{
+	{"network", "HIERO_NETWORK", func(c *_ClientConfig) *interface{} { return &c.Network }},
+	{"mirrorNetwork", "HIERO_MIRROR_NETWORK", func(c *_ClientConfig) *interface{} { return &c.MirrorNetwork }},
+	{"shard", "HIERO_SHARD", func(c *_ClientConfig) *interface{} { return &c.Shard }},
+	{"realm", "HIERO_REALM", func(c *_ClientConfig) *interface{} { return &c.Realm }},
+	{"operator.accountId", "HIERO_OPERATOR_ACCOUNT_ID", func(c *_ClientConfig) *interface{} { return &c._Operator().AccountID }},
+	{"operator.privateKey", "HIERO_OPERATOR_PRIVATE_KEY", func(c *_ClientConfig) *interface{} { return &c._Operator().PrivateKey }},
+	{"operator.keystore", "HIERO_OPERATOR_KEYSTORE", func(c *_ClientConfig) *interface{} { return &c._Operator().Keystore }},
+	{"operator.passphrase", "HIERO_OPERATOR_PASSPHRASE", func(c *_ClientConfig) *interface{} { return &c._Operator().Passphrase }},
+	{"maxAttempts", "HIERO_MAX_ATTEMPTS", func(c *_ClientConfig) *interface{} { return &c.MaxAttempts }},
+	{"maxNodeAttempts", "HIERO_MAX_NODE_ATTEMPTS", func(c *_ClientConfig) *interface{} { return &c.MaxNodeAttempts }},
+	{"maxNodesPerTransaction", "HIERO_MAX_NODES_PER_TRANSACTION", func(c *_ClientConfig) *interface{} { return &c.MaxNodesPerTransaction }},
+	{"minBackoff", "HIERO_MIN_BACKOFF", func(c *_ClientConfig) *interface{} { return &c.MinBackoff }},
+	{"maxBackoff", "HIERO_MAX_BACKOFF", func(c *_ClientConfig) *interface{} { return &c.MaxBackoff }},
+	{"nodeMinBackoff", "HIERO_NODE_MIN_BACKOFF", func(c *_ClientConfig) *interface{} { return &c.NodeMinBackoff }},
+	{"nodeMaxBackoff", "HIERO_NODE_MAX_BACKOFF", func(c *_ClientConfig) *interface{} { return &c.NodeMaxBackoff }},
+	{"nodeMinReadmitPeriod", "HIERO_NODE_MIN_READMIT_PERIOD", func(c *_ClientConfig) *interface{} { return &c.NodeMinReadmitPeriod }},
+	{"nodeMaxReadmitPeriod", "HIERO_NODE_MAX_READMIT_PERIOD", func(c *_ClientConfig) *interface{} { return &c.NodeMaxReadmitPeriod }},
+	{"requestTimeout", "HIERO_REQUEST_TIMEOUT", func(c *_ClientConfig) *interface{} { return &c.RequestTimeout }},
+	{"networkUpdatePeriod", "HIERO_NETWORK_UPDATE_PERIOD", func(c *_ClientConfig) *interface{} { return &c.NetworkUpdatePeriod }},
+	{"defaultMaxTransactionFee", "HIERO_DEFAULT_MAX_TRANSACTION_FEE", func(c *_ClientConfig) *interface{} { return &c.DefaultMaxTransactionFee }},
+	{"defaultMaxQueryPayment", "HIERO_DEFAULT_MAX_QUERY_PAYMENT", func(c *_ClientConfig) *interface{} { return &c.DefaultMaxQueryPayment }},
+	{"transportSecurity", "HIERO_TRANSPORT_SECURITY", func(c *_ClientConfig) *interface{} { return &c.TransportSecurity }},
+	{"verifyCertificate", "HIERO_VERIFY_CERTIFICATE", func(c *_ClientConfig) *interface{} { return &c.VerifyCertificate }},
+	{"autoValidateChecksums", "HIERO_AUTO_VALIDATE_CHECKSUMS", func(c *_ClientConfig) *interface{} { return &c.AutoValidateChecksums }},
+	{"defaultRegenerateTransactionIDs", "HIERO_DEFAULT_REGENERATE_TRANSACTION_IDS", func(c *_ClientConfig) *interface{} { return &c.DefaultRegenerateTransactionIDs }},
+	{"logLevel", "HIERO_LOG_LEVEL", func(c *_ClientConfig) *interface{} { return &c.LogLevel }},
+}
+
+func (config *_ClientConfig) _Operator() *_ConfigOperator {
+	if config.Operator == nil {
+		config.Operator = &_ConfigOperator{}
+	}
+	return config.Operator
+}
+
+// _ApplyEnvironment overrides the fields of the configuration with the HIERO_* environment variables which are set
+func (config *_ClientConfig) _ApplyEnvironment(lookupEnv func(string) (string, bool)) {
+	for _, field := range _ClientConfigFields {
+		value, ok := lookupEnv(field.envVar)
+		if !ok {
+			continue
+		}
+
+		switch field.name {
+		case "network":
+			*field.value(config) = _ConfigNetworkFromEnv(value)
+		case "mirrorNetwork":
+			*field.value(config) = _ConfigMirrorNetworkFromEnv(value)
+		default:
+			*field.value(config) = value
+		}
+
+		if config.envVars == nil {
+			config.envVars = make(map[string]string)
+		}
+		config.envVars[field.name] = field.envVar
+	}
+}
+
+func _ConfigNetworkFromEnv(value string) interface{} {
+	if !strings.Contains(value, "=") {
+		return value
+	}
+
+	network := make(map[string]interface{})
+	for _, node := range strings.Split(value, ",") {
+		address, accountID, _ := strings.Cut(strings.TrimSpace(node), "=")
+		network[address] = accountID
+	}
+	return network
+}
+
+func _ConfigMirrorNetworkFromEnv(value string) interface{} {
+	if !strings.ContainsAny(value, ",:") {
+		return value
+	}
+
+	mirrorNetwork := make([]interface{}, 0)
+	for _, node := range strings.Split(value, ",") {
+		address, restURL, ok := strings.Cut(strings.TrimSpace(node), "=")
+		if !ok {
+			mirrorNetwork = append(mirrorNetwork, address)
+			continue
+		}
+		mirrorNetwork = append(mirrorNetwork, map[string]interface{}{"grpc": address, "rest": restURL})
+	}
+	return mirrorNetwork
+}
+
+func (config *_ClientConfig) _Error(field string, err error) error {
+	return ErrInvalidClientConfig{Field: field, EnvVar: config.envVars[field], Err: err}
+}
+
+func (config *_ClientConfig) _Int(field string, value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, config._Error(field, errors.New("number is too large"))
+		}
+		return int64(v), nil
+	case float64:
+		if v != math.Trunc(v) || v > math.MaxInt64 || v < math.MinInt64 {
+			return 0, config._Error(field, fmt.Errorf("expected an integer, got %v", v))
+		}
+		return int64(v), nil
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return 0, config._Error(field, fmt.Errorf("expected an integer, got %q", v))
+		}
+		return i, nil
+	default:
+		return 0, config._Error(field, fmt.Errorf("expected an integer, got %T", value))
+	}
+}
+
+func (config *_ClientConfig) _Uint(field string, value interface{}) (uint64, error) {
+	if value == nil {
+		return 0, nil
+	}
+
+	i, err := config._Int(field, value)
+	if err != nil {
+		return 0, err
+	}
+	if i < 0 {
+		return 0, config._Error(field, fmt.Errorf("must not be negative, got %d", i))
+	}
+	return uint64(i), nil
+}
+
+func (config *_ClientConfig) _Positive(field string, value interface{}) (int, error) {
+	i, err := config._Int(field, value)
+	if err != nil {
+		return 0, err
+	}
+	if i < 1 || i > math.MaxInt32 {
+		return 0, config._Error(field, fmt.Errorf("must be a positive integer, got %d", i))
+	}
+	return int(i), nil
+}
+
+func (config *_ClientConfig) _Duration(field string, value interface{}) (time.Duration, error) {
+	var duration time.Duration
+	switch v := value.(type) {
+	case string:
+		parsed, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return 0, config._Error(field, fmt.Errorf("expected a duration like \"250ms\", got %q", v))
+		}
+		duration = parsed
+	default:
+		milliseconds, err := config._Int(field, value)
+		if err != nil {
+			return 0, config._Error(field, fmt.Errorf("expected a duration like \"250ms\" or a number of milliseconds, got %v", value))
+		}
+		duration = time.Duration(milliseconds) * time.Millisecond
+	}
+
+	if duration < 0 {
+		return 0, config._Error(field, fmt.Errorf("must not be negative, got %v", duration))
+	}
+	return duration, nil
+}
+
+func (config *_ClientConfig) _Bool(field string, value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, config._Error(field, fmt.Errorf("expected true or false, got %q", v))
+		}
+		return b, nil
+	default:
+		return false, config._Error(field, fmt.Errorf("expected true or false, got %T", value))
+	}
+}
+
+func (config *_ClientConfig) _Hbar(field string, value interface{}) (Hbar, error) {
+	var hbar Hbar
+	switch v := value.(type) {
+	case string:
+		parsed, err := HbarFromString(strings.TrimSpace(v))
+		if err != nil {
+			return Hbar{}, config._Error(field, fmt.Errorf("expected an amount like \"2 ℏ\", got %q", v))
+		}
+		hbar = parsed
+	case int:
+		hbar = NewHbar(float64(v))
+	case float64:
+		hbar = NewHbar(v)
+	default:
+		return Hbar{}, config._Error(field, fmt.Errorf("expected an amount like \"2 ℏ\" or a number of hbars, got %T", value))
+	}
+
+	if hbar.AsTinybar() < 0 {
+		return Hbar{}, config._Error(field, fmt.Errorf("must not be negative, got %v", hbar))
+	}
+	return hbar, nil
+}
+
+func (config *_ClientConfig) _String(field string, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		return "", config._Error(field, fmt.Errorf("expected a string, got %T", value))
+	}
+}
+
+func (config *_ClientConfig) _LogLevel(field string, value interface{}) (LogLevel, error) {
+	level, err := config._String(field, value)
+	if err != nil {
+		return "", err
+	}
+
+	switch LogLevel(strings.ToUpper(level)) {
+	case LoggerLevelTrace, LoggerLevelDebug, LoggerLevelInfo, LoggerLevelWarn, LoggerLevelError, LoggerLevelDisabled:
+		return LogLevel(strings.ToUpper(level)), nil
+	default:
+		return "", config._Error(field, fmt.Errorf("expected one of TRACE, DEBUG, INFO, WARN, ERROR or DISABLED, got %q", level))
+	}
+}
+
+// _OperatorKey reads the private key of the operator from its string or keystore form
+func (config *_ClientConfig) _OperatorKey() (PrivateKey, error) {
+	operator := config.Operator
+	passphrase, err := config._String("operator.passphrase", operator.Passphrase)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	if operator.Keystore != nil {
+		if operator.PrivateKey != nil {
+			return PrivateKey{}, config._Error("operator.keystore", errors.New("only one of operator.privateKey and operator.keystore can be set"))
+		}
+
+		var keystore []byte
+		switch ks := operator.Keystore.(type) {
+		case string:
+			keystore = []byte(ks)
+		case map[string]interface{}:
+			if keystore, err = json.Marshal(ks); err != nil {
+				return PrivateKey{}, config._Error("operator.keystore", err)
+			}
+		default:
+			return PrivateKey{}, config._Error("operator.keystore", fmt.Errorf("expected a keystore object or string, got %T", operator.Keystore))
+		}
+
+		key, err := PrivateKeyFromKeystore(keystore, passphrase)
+		if err != nil {
+			return PrivateKey{}, config._Error("operator.keystore", err)
+		}
+		return key, nil
+	}
+
+	privateKey, err := config._String("operator.privateKey", operator.PrivateKey)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	if privateKey == "" {
+		return PrivateKey{}, config._Error("operator.privateKey", errors.New("operator.privateKey or operator.keystore is required"))
+	}
+
+	var key PrivateKey
+	if strings.HasPrefix(strings.TrimSpace(privateKey), "-----BEGIN") {
+		key, err = PrivateKeyFromPem([]byte(privateKey), passphrase)
+	} else {
+		key, err = PrivateKeyFromString(privateKey)
+	}
+	if err != nil {
+		return PrivateKey{}, config._Error("operator.privateKey", err)
+	}
+	return key, nil
+}
+
+// _ApplyOperator sets the operator of the client, when the configuration has one
+func (config *_ClientConfig) _ApplyOperator(client *Client) error {
+	if config.Operator == nil {
+		return nil
+	}
+
+	accountID, err := config._String("operator.accountId", config.Operator.AccountID)
+	if err != nil {
+		return err
+	}
+	if accountID == "" {
+		return config._Error("operator.accountId", errors.New("the account ID of the operator is required"))
+	}
+
+	operatorID, err := AccountIDFromString(accountID)
+	if err != nil {
+		return config._Error("operator.accountId", err)
+	}
+
+	operatorKey, err := config._OperatorKey()
+	if err != nil {
+		return err
+	}
+
+	client.operator = &_Operator{
+		accountID:  operatorID,
+		privateKey: &operatorKey,
+		publicKey:  operatorKey.PublicKey(),
+		signer:     operatorKey.Sign,
+	}
+
+	return nil
+}
+
+// _ApplySettings validates the settings of the configuration and applies them to the client. Nothing is applied
+// when a setting is invalid.
+func (config *_ClientConfig) _ApplySettings(client *Client) error { // nolint
+	var settings []func()
+
+	for _, field := range []struct {
+		name  string
+		value interface{}
+		set   func(int)
+	}{
+		{"maxAttempts", config.MaxAttempts, client.SetMaxAttempts},
+		{"maxNodeAttempts", config.MaxNodeAttempts, client.SetMaxNodeAttempts},
+		{"maxNodesPerTransaction", config.MaxNodesPerTransaction, client.SetMaxNodesPerTransaction},
+	} {
+		if field.value == nil {
+			continue
+		}
+		value, err := config._Positive(field.name, field.value)
+		if err != nil {
+			return err
+		}
+		set := field.set
+		settings = append(settings, func() { set(value) })
+	}
+
+	// The backoffs are validated together, as the client panics on a minBackoff above its maxBackoff
+	minBackoff, maxBackoff := client.minBackoff, client.maxBackoff
+	var err error
+	if config.MinBackoff != nil {
+		if minBackoff, err = config._Duration("minBackoff", config.MinBackoff); err != nil {
+			return err
+		}
+	}
+	if config.MaxBackoff != nil {
+		if maxBackoff, err = config._Duration("maxBackoff", config.MaxBackoff); err != nil {
+			return err
+		}
+	}
+	if minBackoff > maxBackoff {
+		field := "minBackoff"
+		if config.MinBackoff == nil {
+			field = "maxBackoff"
+		}
+		return config._Error(field, fmt.Errorf("minBackoff %v must be less than or equal to maxBackoff %v", minBackoff, maxBackoff))
+	}
+	settings = append(settings, func() {
+		client.minBackoff = minBackoff
+		client.maxBackoff = maxBackoff
+	})
+
+	for _, field := range []struct {
+		name  string
+		value interface{}
+		set   func(time.Duration)
+	}{
+		{"nodeMinBackoff", config.NodeMinBackoff, client.SetNodeMinBackoff},
+		{"nodeMaxBackoff", config.NodeMaxBackoff, client.SetNodeMaxBackoff},
+		{"nodeMinReadmitPeriod", config.NodeMinReadmitPeriod, client.SetNodeMinReadmitPeriod},
+		{"nodeMaxReadmitPeriod", config.NodeMaxReadmitPeriod, client.SetNodeMaxReadmitPeriod},
+		{"requestTimeout", config.RequestTimeout, func(d time.Duration) { client.SetRequestTimeout(&d) }},
+		{"networkUpdatePeriod", config.NetworkUpdatePeriod, func(d time.Duration) { client.SetNetworkUpdatePeriod(d) }},
+	} {
+		if field.value == nil {
+			continue
+		}
+		value, err := config._Duration(field.name, field.value)
+		if err != nil {
+			return err
+		}
+		set := field.set
+		settings = append(settings, func() { set(value) })
+	}
+
+	if config.DefaultMaxTransactionFee != nil {
+		fee, err := config._Hbar("defaultMaxTransactionFee", config.DefaultMaxTransactionFee)
+		if err != nil {
+			return err
+		}
+		settings = append(settings, func() { _ = client.SetDefaultMaxTransactionFee(fee) })
+	}
+	if config.DefaultMaxQueryPayment != nil {
+		payment, err := config._Hbar("defaultMaxQueryPayment", config.DefaultMaxQueryPayment)
+		if err != nil {
+			return err
+		}
+		settings = append(settings, func() { _ = client.SetDefaultMaxQueryPayment(payment) })
+	}
+
+	for _, field := range []struct {
+		name  string
+		value interface{}
+		set   func(bool)
+	}{
+		{"transportSecurity", config.TransportSecurity, func(b bool) { client.SetTransportSecurity(b) }},
+		{"verifyCertificate", config.VerifyCertificate, func(b bool) { client.SetCertificateVerification(b) }},
+		{"autoValidateChecksums", config.AutoValidateChecksums, client.SetAutoValidateChecksums},
+		{"defaultRegenerateTransactionIDs", config.DefaultRegenerateTransactionIDs, client.SetDefaultRegenerateTransactionIDs},
+	} {
+		if field.value == nil {
+			continue
+		}
+		value, err := config._Bool(field.name, field.value)
+		if err != nil {
+			return err
+		}
+		set := field.set
+		settings = append(settings, func() { set(value) })
+	}
+
+	if config.LogLevel != nil {
+		level, err := config._LogLevel("logLevel", config.LogLevel)
+		if err != nil {
+			return err
+		}
+		settings = append(settings, func() { client.SetLogLevel(level) })
+	}
+
+	for _, setting := range settings {
+		setting()
+	}
+
+	return nil
+}
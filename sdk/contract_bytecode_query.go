@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -45,12 +46,17 @@ func (q *ContractBytecodeQuery) GetContractID() ContractID {
 }
 
 func (q *ContractBytecodeQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *ContractBytecodeQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]byte, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return []byte{}, err
@@ -107,6 +113,12 @@ func (q *ContractBytecodeQuery) SetLogLevel(level LogLevel) *ContractBytecodeQue
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *ContractBytecodeQuery) SetRetryPolicy(policy RetryPolicy) *ContractBytecodeQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *ContractBytecodeQuery) getMethod(channel *_Channel) _Method {
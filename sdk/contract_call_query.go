@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -114,12 +115,17 @@ func (q *ContractCallQuery) GetFunctionParameters() []byte {
 }
 
 func (q *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *ContractCallQuery) Execute(client *Client) (ContractFunctionResult, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *ContractCallQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractFunctionResult, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return ContractFunctionResult{}, err
@@ -176,6 +182,12 @@ func (q *ContractCallQuery) SetLogLevel(level LogLevel) *ContractCallQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *ContractCallQuery) SetRetryPolicy(policy RetryPolicy) *ContractCallQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *ContractCallQuery) getMethod(channel *_Channel) _Method {
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"encoding/hex"
 	"time"
 
@@ -272,14 +273,21 @@ func (tx *ContractCreateFlow) _CreateContractCreateTransaction(fileID FileID) *C
 	return contractCreateTx
 }
 
+// Execute executes the flow with the provided client
 func (tx *ContractCreateFlow) Execute(client *Client) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the flow with the provided client, stopping at whichever step is running
+// when ctx is done.
+func (tx *ContractCreateFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	tx.splitBytecode()
 
-	fileCreateResponse, err := tx._CreateFileCreateTransaction(client).Execute(client)
+	fileCreateResponse, err := tx._CreateFileCreateTransaction(client).ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	fileCreateReceipt, err := fileCreateResponse.SetValidateStatus(true).GetReceipt(client)
+	fileCreateReceipt, err := fileCreateResponse.SetValidateStatus(true).GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
@@ -288,21 +296,21 @@ func (tx *ContractCreateFlow) Execute(client *Client) (TransactionResponse, erro
 	}
 	fileID := *fileCreateReceipt.FileID
 	if len(tx.appendBytecode) > 0 {
-		fileAppendResponse, err := tx._CreateFileAppendTransaction(fileID).Execute(client)
+		fileAppendResponse, err := tx._CreateFileAppendTransaction(fileID).ExecuteWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
 
-		_, err = fileAppendResponse.SetValidateStatus(true).GetReceipt(client)
+		_, err = fileAppendResponse.SetValidateStatus(true).GetReceiptWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
 	}
-	contractCreateResponse, err := tx._CreateContractCreateTransaction(fileID).Execute(client)
+	contractCreateResponse, err := tx._CreateContractCreateTransaction(fileID).ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	_, err = contractCreateResponse.SetValidateStatus(true).GetReceipt(client)
+	_, err = contractCreateResponse.SetValidateStatus(true).GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
@@ -3,10 +3,9 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 
@@ -168,37 +167,25 @@ func (id ContractID) ToEvmAddress() string {
 // Should be used after generating `ContractId.FromEvmAddress()` because it sets the `Contract` field to `0`
 // automatically since there is no connection between the `Contract` and the `evmAddress`
 func (id *ContractID) PopulateContract(client *Client) error {
-	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return errors.New("mirror node is not set")
-	}
-	mirrorUrl := client.GetMirrorNetwork()[0]
-	index := strings.Index(mirrorUrl, ":")
-	if index == -1 {
-		return errors.New("invalid mirrorUrl format")
-	}
-	mirrorUrl = mirrorUrl[:index]
-	url := fmt.Sprintf("https://%s/api/v1/contracts/%s", mirrorUrl, hex.EncodeToString(id.EvmAddress))
-	if client.GetLedgerID() == nil {
-		url = fmt.Sprintf("http://%s:5551/api/v1/contracts/%s", mirrorUrl, hex.EncodeToString(id.EvmAddress))
-	}
+	return id.PopulateContractWithContext(context.Background(), client)
+}
 
-	resp, err := http.Get(url) // #nosec
-	if err != nil {
-		return err
+// PopulateContractWithContext is PopulateContract, stopping early when ctx is done.
+func (id *ContractID) PopulateContractWithContext(ctx context.Context, client *Client) error {
+	if client == nil {
+		return errNoClientProvided
 	}
-	defer resp.Body.Close()
 
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	result, err := client.GetMirrorNodeClient().GetContract(ctx, hex.EncodeToString(id.EvmAddress))
+	if err != nil {
 		return err
 	}
 
-	mirrorContractId, ok := result["contract_id"].(string)
-	if !ok {
+	if result.ContractID == "" {
 		return errors.New("unexpected response format")
 	}
 
-	numStr := mirrorContractId[strings.LastIndex(mirrorContractId, ".")+1:]
+	numStr := result.ContractID[strings.LastIndex(result.ContractID, ".")+1:]
 	num, err := strconv.ParseInt(numStr, 10, 64)
 	if err != nil {
 		return err
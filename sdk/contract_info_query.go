@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -47,12 +48,17 @@ func (q *ContractInfoQuery) GetContractID() ContractID {
 }
 
 func (q *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *ContractInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractInfo, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return ContractInfo{}, err
@@ -113,6 +119,12 @@ func (q *ContractInfoQuery) SetLogLevel(level LogLevel) *ContractInfoQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *ContractInfoQuery) SetRetryPolicy(policy RetryPolicy) *ContractInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *ContractInfoQuery) getMethod(channel *_Channel) _Method {
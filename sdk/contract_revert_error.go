@@ -0,0 +1,237 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ContractRevertKind is how a contract reverted
+type ContractRevertKind int
+
+const (
+	// ContractRevertUnknown is a revert whose data does not match a known error
+	ContractRevertUnknown ContractRevertKind = iota
+	// ContractRevertReason is a revert with a reason, `require(condition, "reason")` or `revert("reason")`,
+	// encoded as `Error(string)`
+	ContractRevertReason
+	// ContractRevertPanic is a failed assertion or an arithmetic or memory error, encoded as `Panic(uint256)`
+	ContractRevertPanic
+	// ContractRevertCustom is a custom error of the ABI, `revert InsufficientBalance(...)`
+	ContractRevertCustom
+)
+
+// String returns the name of the kind
+func (kind ContractRevertKind) String() string {
+	switch kind {
+	case ContractRevertReason:
+		return "REASON"
+	case ContractRevertPanic:
+		return "PANIC"
+	case ContractRevertCustom:
+		return "CUSTOM"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// The selectors of the errors the Solidity compiler emits
+var (
+	revertReasonSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	revertPanicSelector  = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "incorrectly encoded storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// ContractRevertError is why a contract function reverted, decoded from the revert data
+type ContractRevertError struct {
+	Kind ContractRevertKind
+	// Reason is the reason of an Error(string) revert, or the error message when the revert has no data
+	Reason string
+	// PanicCode is the code of a Panic(uint256) revert
+	PanicCode uint64
+	// CustomError is the custom error of the ABI matching the selector of a custom revert, and Args its decoded
+	// params
+	CustomError *Error
+	Args        map[string]interface{}
+	// Data is the raw revert data
+	Data []byte
+}
+
+// Error() implements the Error interface
+func (e *ContractRevertError) Error() string {
+	switch e.Kind {
+	case ContractRevertReason:
+		return fmt.Sprintf("contract reverted: %s", e.Reason)
+	case ContractRevertPanic:
+		return fmt.Sprintf("contract panicked: %s (0x%02x)", e.PanicReason(), e.PanicCode)
+	case ContractRevertCustom:
+		return fmt.Sprintf("contract reverted with %s %v", e.CustomError.Sig(), e.Args)
+	default:
+		if len(e.Data) > 0 {
+			return fmt.Sprintf("contract reverted with data 0x%x", e.Data)
+		}
+		if e.Reason != "" {
+			return fmt.Sprintf("contract reverted: %s", e.Reason)
+		}
+		return "contract reverted"
+	}
+}
+
+// PanicReason returns what the panic code of a Panic(uint256) revert means
+func (e *ContractRevertError) PanicReason() string {
+	if reason, ok := panicReasons[e.PanicCode]; ok {
+		return reason
+	}
+
+	return "unknown panic"
+}
+
+// Sig returns the signature of the error
+func (e *Error) Sig() string {
+	return buildSignature(e.Name, e.Inputs)
+}
+
+// ID returns the selector of the error, the first 4 bytes of its revert data
+func (e *Error) ID() []byte {
+	return Keccak256Hash([]byte(e.Sig())).Bytes()[:4]
+}
+
+// DecodeRevert decodes the revert data of a contract function, recognizing reasons, panics and the custom errors
+// of abi, which may be nil. Data which matches none of them is kept in a ContractRevertUnknown error.
+func DecodeRevert(abi *ABI, data []byte) *ContractRevertError {
+	revert := &ContractRevertError{Kind: ContractRevertUnknown, Data: data}
+	if len(data) < 4 {
+		return revert
+	}
+
+	selector, params := data[:4], data[4:]
+	switch {
+	case bytes.Equal(selector, revertReasonSelector):
+		if values, err := _DecodeRevertParams("tuple(string)", params); err == nil {
+			revert.Kind = ContractRevertReason
+			revert.Reason = values["0"].(string)
+		}
+		return revert
+
+	case bytes.Equal(selector, revertPanicSelector):
+		if values, err := _DecodeRevertParams("tuple(uint256)", params); err == nil {
+			if code := values["0"].(*big.Int); code.IsUint64() {
+				revert.Kind = ContractRevertPanic
+				revert.PanicCode = code.Uint64()
+			}
+		}
+		return revert
+	}
+
+	if abi == nil {
+		return revert
+	}
+
+	for _, customError := range abi.Errors {
+		if !bytes.Equal(selector, customError.ID()) {
+			continue
+		}
+
+		args := map[string]interface{}{}
+		if len(customError.Inputs.TupleElems()) > 0 {
+			decoded, err := Decode(customError.Inputs, params)
+			if err != nil {
+				continue
+			}
+			args = decoded.(map[string]interface{})
+		}
+
+		revert.Kind = ContractRevertCustom
+		revert.CustomError = customError
+		revert.Args = args
+		return revert
+	}
+
+	return revert
+}
+
+// DecodeRevert decodes why the contract function reverted, from the revert data in the error message or call
+// result, like the package level DecodeRevert. It returns nil if the function did not revert.
+func (result ContractFunctionResult) DecodeRevert(abi *ABI) *ContractRevertError {
+	if result.ErrorMessage == "" {
+		return nil
+	}
+
+	if data, ok := _DecodeRevertHex(result.ErrorMessage); ok {
+		return DecodeRevert(abi, data)
+	}
+	if len(result.ContractCallResult) >= 4 {
+		return DecodeRevert(abi, result.ContractCallResult)
+	}
+
+	return &ContractRevertError{Kind: ContractRevertUnknown, Reason: result.ErrorMessage}
+}
+
+// DecodeRevert decodes why the contract call simulated by the mirror node reverted, from the revert data in the
+// details of the error, like the package level DecodeRevert. It returns nil if the details have no revert data.
+func (e ErrMirrorNodeStatus) DecodeRevert(abi *ABI) *ContractRevertError {
+	var details struct {
+		Status struct {
+			Messages []struct {
+				Message string `json:"message"`
+				Data    string `json:"data"`
+			} `json:"messages"`
+		} `json:"_status"`
+	}
+	if err := json.Unmarshal([]byte(e.Details), &details); err != nil {
+		return nil
+	}
+
+	for _, message := range details.Status.Messages {
+		if data, ok := _DecodeRevertHex(message.Data); ok {
+			return DecodeRevert(abi, data)
+		}
+	}
+
+	return nil
+}
+
+func _DecodeRevertParams(typ string, params []byte) (map[string]interface{}, error) {
+	tuple, err := NewType(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := Decode(tuple, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return values.(map[string]interface{}), nil
+}
+
+// _DecodeRevertHex decodes revert data encoded as a 0x prefixed hex string
+func _DecodeRevertHex(message string) ([]byte, bool) {
+	if !strings.HasPrefix(message, "0x") || len(message) < 2+8 {
+		return nil, false
+	}
+
+	data, err := hex.DecodeString(message[2:])
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
@@ -0,0 +1,119 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestRevertData(t *testing.T, selector []byte, typ string, args ...interface{}) []byte {
+	tuple, err := NewType(typ)
+	require.NoError(t, err)
+	params, err := Encode(args, tuple)
+	require.NoError(t, err)
+
+	return append(append([]byte{}, selector...), params...)
+}
+
+func TestUnitDecodeRevertReason(t *testing.T) {
+	t.Parallel()
+
+	data := _TestRevertData(t, revertReasonSelector, "tuple(string)", "not enough funds")
+
+	revert := DecodeRevert(nil, data)
+	assert.Equal(t, ContractRevertReason, revert.Kind)
+	assert.Equal(t, "not enough funds", revert.Reason)
+	assert.Equal(t, data, revert.Data)
+	assert.Equal(t, "contract reverted: not enough funds", revert.Error())
+
+	result := ContractFunctionResult{ErrorMessage: "0x" + hex.EncodeToString(data)}
+	assert.Equal(t, revert, result.DecodeRevert(nil))
+}
+
+func TestUnitDecodeRevertPanic(t *testing.T) {
+	t.Parallel()
+
+	revert := DecodeRevert(nil, _TestRevertData(t, revertPanicSelector, "tuple(uint256)", big.NewInt(0x11)))
+	assert.Equal(t, ContractRevertPanic, revert.Kind)
+	assert.Equal(t, uint64(0x11), revert.PanicCode)
+	assert.Equal(t, "arithmetic overflow or underflow", revert.PanicReason())
+	assert.Equal(t, "contract panicked: arithmetic overflow or underflow (0x11)", revert.Error())
+
+	revert = DecodeRevert(nil, _TestRevertData(t, revertPanicSelector, "tuple(uint256)", big.NewInt(0x99)))
+	assert.Equal(t, ContractRevertPanic, revert.Kind)
+	assert.Equal(t, "unknown panic", revert.PanicReason())
+}
+
+func TestUnitDecodeRevertCustomError(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABIFromList([]string{
+		"error InsufficientBalance(uint256 available, uint256 required)",
+		"error Unauthorized()",
+	})
+	require.NoError(t, err)
+
+	insufficient := abi.Errors["InsufficientBalance"]
+	assert.Equal(t, "InsufficientBalance(uint256,uint256)", insufficient.Sig())
+	assert.Equal(t, "cf479181", hex.EncodeToString(insufficient.ID()))
+
+	data := _TestRevertData(t, insufficient.ID(), "tuple(uint256,uint256)", big.NewInt(5), big.NewInt(10))
+	result := ContractFunctionResult{ErrorMessage: "CONTRACT_REVERT_EXECUTED", ContractCallResult: data}
+
+	revert := result.DecodeRevert(abi)
+	assert.Equal(t, ContractRevertCustom, revert.Kind)
+	assert.Equal(t, insufficient, revert.CustomError)
+	assert.Equal(t, big.NewInt(5), revert.Args["available"])
+	assert.Equal(t, big.NewInt(10), revert.Args["required"])
+	assert.Contains(t, revert.Error(), "InsufficientBalance(uint256,uint256)")
+
+	revert = DecodeRevert(abi, abi.Errors["Unauthorized"].ID())
+	assert.Equal(t, ContractRevertCustom, revert.Kind)
+	assert.Empty(t, revert.Args)
+
+	// Without the ABI the custom error is unknown
+	revert = result.DecodeRevert(nil)
+	assert.Equal(t, ContractRevertUnknown, revert.Kind)
+	assert.Equal(t, data, revert.Data)
+	assert.Equal(t, "contract reverted with data 0x"+hex.EncodeToString(data), revert.Error())
+}
+
+func TestUnitDecodeRevertWithoutData(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ContractFunctionResult{}.DecodeRevert(nil))
+
+	revert := ContractFunctionResult{ErrorMessage: "INSUFFICIENT_GAS"}.DecodeRevert(nil)
+	assert.Equal(t, ContractRevertUnknown, revert.Kind)
+	assert.Equal(t, "contract reverted: INSUFFICIENT_GAS", revert.Error())
+
+	// Data with the reason selector which cannot be decoded is kept as is
+	revert = DecodeRevert(nil, append(append([]byte{}, revertReasonSelector...), 1, 2))
+	assert.Equal(t, ContractRevertUnknown, revert.Kind)
+}
+
+func TestUnitDecodeRevertMirrorNodeStatus(t *testing.T) {
+	t.Parallel()
+
+	data := _TestRevertData(t, revertReasonSelector, "tuple(string)", "paused")
+	err := ErrMirrorNodeStatus{
+		StatusCode: 400,
+		Details:    `{"_status":{"messages":[{"message":"CONTRACT_REVERT_EXECUTED","detail":"paused","data":"0x` + hex.EncodeToString(data) + `"}]}}`,
+	}
+
+	revert := err.DecodeRevert(nil)
+	require.NotNil(t, revert)
+	assert.Equal(t, ContractRevertReason, revert.Kind)
+	assert.Equal(t, "paused", revert.Reason)
+
+	assert.Nil(t, ErrMirrorNodeStatus{StatusCode: 400, Details: `{"_status":{"messages":[{"message":"Bad request"}]}}`}.DecodeRevert(nil))
+	assert.Nil(t, ErrMirrorNodeStatus{StatusCode: 502, Details: "bad gateway"}.DecodeRevert(nil))
+}
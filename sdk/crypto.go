@@ -501,7 +501,8 @@ func _DeriveECDSAChildKey(parentKey []byte, chainCode []byte, index uint32) ([]b
 	ki.Add(privKey.ToECDSA().D, il)
 	ki.Mod(ki, privKey.ToECDSA().Curve.Params().N)
 
-	return ki.Bytes(), ir, nil
+	// The child key keeps its leading zeros, so it can derive children of its own
+	return ki.FillBytes(make([]byte, 32)), ir, nil
 }
 
 func _DeriveLegacyChildKey(parentKey []byte, index int64) ([]byte, error) {
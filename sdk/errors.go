@@ -21,6 +21,7 @@ var errNoClientOrTransactionID = errors.New("`client` must have an `_Operator` o
 var errNoClientOrTransactionIDOrNodeId = errors.New("`client` must be provided or both `nodeId` and `transactionId` must be set") // nolint
 var errClientOperatorSigning = errors.New("`client` must have an `_Operator` to sign with the _Operator")
 var errNoClientProvided = errors.New("`client` must be provided and have an _Operator")
+var errStreamDropWithCheckpoint = errors.New("a stream with a checkpoint store cannot drop messages, as a dropped message would be checkpointed without being delivered")
 var errTransactionIsNotFrozen = errors.New("transaction is not frozen")
 var errInnerTransactionShouldBeFrozen = errors.New("inner transaction should be frozen")
 var errFailedToDeserializeBytes = errors.New("failed to deserialize bytes")
@@ -34,9 +35,13 @@ var errChecksumMissing = errors.New("no checksum provided")
 var errLockedSlice = errors.New("slice is locked")
 var errBatchedAndNotBatchTransaction = errors.New("cannot execute batchified transaction outside of BatchTransaction")
 var errNodeIsUnhealthy = errors.New("node is unhealthy")
+var errNoHealthyNodes = errors.New("failed to find a healthy working node")
 var errNodeIdIsRequired = errors.New("nodeID is required")
 var errEvmAddressIsNotALongZeroAddress = errors.New("EVM address is not a correct long zero address")
 var errEvmAddressIsNotCorrectSize = errors.New("EVM address is not the correct size")
+var errRLPTruncated = errors.New("RLP item is longer than the bytes holding it")
+var errEthereumSignerNotECDSA = errors.New("ethereum transactions can only be signed with ECDSA secp256k1 keys")
+var errEthereumSignatureInvalid = errors.New("ethereum transaction signature does not recover a public key")
 
 // Batch transaction specific errors
 var errInnerTransactionNil = errors.New("inner transaction cannot be nil")
@@ -156,3 +161,100 @@ type ErrLocalValidation struct {
 func (e ErrLocalValidation) Error() string {
 	return e.message
 }
+
+// ErrExecutionInterrupted is returned by the context-aware Execute variants when the provided context is
+// cancelled or its deadline is exceeded before execution completes.
+type ErrExecutionInterrupted struct {
+	// The attempt (starting from 1) that was in progress or about to start when the context ended
+	Attempt int64
+	// The maximum number of attempts that were allowed for the request
+	MaxAttempts int
+	// The context error, either context.Canceled or context.DeadlineExceeded
+	Cause error
+	// The last error received from the network before the interruption, if any
+	LastError error
+}
+
+// Error() implements the Error interface
+func (e ErrExecutionInterrupted) Error() string {
+	if e.LastError != nil {
+		return fmt.Sprintf("execution interrupted during attempt %d/%d: %s (last error: %s)", e.Attempt, e.MaxAttempts, e.Cause, e.LastError)
+	}
+	return fmt.Sprintf("execution interrupted during attempt %d/%d: %s", e.Attempt, e.MaxAttempts, e.Cause)
+}
+
+// Unwrap returns the context error so errors.Is(err, context.Canceled) works as expected
+func (e ErrExecutionInterrupted) Unwrap() error {
+	return e.Cause
+}
+
+// ErrMirrorNodeStatus is returned by MirrorNodeClient requests when the mirror node responds with a non-200 status.
+type ErrMirrorNodeStatus struct {
+	StatusCode int
+	URL        string
+	Details    string
+}
+
+// Error() implements the Error interface
+func (e ErrMirrorNodeStatus) Error() string {
+	return fmt.Sprintf("received non-200 response from Mirror Node: %d, details: %s", e.StatusCode, e.Details)
+}
+
+// ErrIncompleteTopicMessage is passed to the chunk error handler of a TopicMessageQuery when the chunks of a
+// message do not all arrive within the chunk timeout, and the received chunks are dropped.
+type ErrIncompleteTopicMessage struct {
+	TransactionID  TransactionID
+	ReceivedChunks int
+	TotalChunks    int
+}
+
+// Error() implements the Error interface
+func (e ErrIncompleteTopicMessage) Error() string {
+	return fmt.Sprintf("received %d of %d chunks of topic message %v before the chunk timeout", e.ReceivedChunks, e.TotalChunks, e.TransactionID)
+}
+
+// ErrTopicSequenceGap is passed to the sequence gap handler of a TopicMessageQuery when the sequence number of a
+// received message is not the one following the previous message.
+type ErrTopicSequenceGap struct {
+	TopicID  TopicID
+	Expected uint64
+	Received uint64
+}
+
+// Error() implements the Error interface
+func (e ErrTopicSequenceGap) Error() string {
+	return fmt.Sprintf("expected message %d of topic %v but received message %d", e.Expected, e.TopicID, e.Received)
+}
+
+// ErrInvalidClientConfig is returned by ClientFromConfig and ClientFromConfigFile when a field of the configuration,
+// or the environment variable overriding it, is invalid.
+type ErrInvalidClientConfig struct {
+	// The name of the field, like maxAttempts or operator.privateKey
+	Field string
+	// The environment variable the value came from, empty when it came from the configuration
+	EnvVar string
+	Err    error
+}
+
+// Error() implements the Error interface
+func (e ErrInvalidClientConfig) Error() string {
+	if e.EnvVar != "" {
+		return fmt.Sprintf("invalid client config field %s (from %s): %s", e.Field, e.EnvVar, e.Err)
+	}
+	return fmt.Sprintf("invalid client config field %s: %s", e.Field, e.Err)
+}
+
+// Unwrap returns the error of the field
+func (e ErrInvalidClientConfig) Unwrap() error {
+	return e.Err
+}
+
+// ErrUnknownNode is returned when a node account ID is not in the network of the client.
+type ErrUnknownNode struct {
+	NodeAccountID AccountID
+}
+
+// Error() implements the Error interface
+func (e ErrUnknownNode) Error() string {
+	return fmt.Sprintf("node %v is not in the network of the client", e.NodeAccountID)
+}
@@ -20,10 +20,11 @@ type EthereumEIP1559Transaction struct {
 	To             []byte
 	Value          []byte
 	CallData       []byte
-	AccessList     [][]byte
-	RecoveryId     []byte
-	R              []byte
-	S              []byte
+	// AccessList holds the RLP encoded entries of the access list, see EthereumAccessListEntry
+	AccessList [][]byte
+	RecoveryId []byte
+	R          []byte
+	S          []byte
 }
 
 // nolint
@@ -65,7 +66,11 @@ func EthereumEIP1559TransactionFromBytes(bytes []byte) (*EthereumEIP1559Transact
 	// Handle the access list
 	var accessListValues [][]byte
 	for _, child := range item.childItems[8].childItems {
-		accessListValues = append(accessListValues, child.itemValue)
+		entryBytes, err := child.Write()
+		if err != nil {
+			return nil, err
+		}
+		accessListValues = append(accessListValues, entryBytes)
 	}
 
 	// Extract values from the RLP item
@@ -96,9 +101,9 @@ func (txn *EthereumEIP1559Transaction) ToBytes() ([]byte, error) {
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.To))
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Value))
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.CallData))
-	accessListItem := NewRLPItem(LIST_TYPE)
-	for _, itemBytes := range txn.AccessList {
-		accessListItem.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(itemBytes))
+	accessListItem, err := _EthereumAccessListItem(txn.AccessList)
+	if err != nil {
+		return nil, err
 	}
 	item.PushBack(accessListItem)
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.RecoveryId))
@@ -12,13 +12,14 @@ import (
 
 // EthereumEIP2930Transaction represents the EIP-2930 Ethereum transaction data.
 type EthereumEIP2930Transaction struct {
-	ChainId    []byte
-	Nonce      []byte
-	GasPrice   []byte
-	GasLimit   []byte
-	To         []byte
-	Value      []byte
-	CallData   []byte
+	ChainId  []byte
+	Nonce    []byte
+	GasPrice []byte
+	GasLimit []byte
+	To       []byte
+	Value    []byte
+	CallData []byte
+	// AccessList holds the RLP encoded entries of the access list, see EthereumAccessListEntry
 	AccessList [][]byte
 	RecoveryId []byte
 	R          []byte
@@ -62,7 +63,11 @@ func EthereumEIP2930TransactionFromBytes(bytes []byte) (*EthereumEIP2930Transact
 	// Handle the access list
 	var accessListValues [][]byte
 	for _, child := range item.childItems[7].childItems {
-		accessListValues = append(accessListValues, child.itemValue)
+		entryBytes, err := child.Write()
+		if err != nil {
+			return nil, err
+		}
+		accessListValues = append(accessListValues, entryBytes)
 	}
 
 	// Extract values from the RLP item
@@ -91,9 +96,9 @@ func (txn *EthereumEIP2930Transaction) ToBytes() ([]byte, error) {
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.To))
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Value))
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.CallData))
-	accessListItem := NewRLPItem(LIST_TYPE)
-	for _, itemBytes := range txn.AccessList {
-		accessListItem.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(itemBytes))
+	accessListItem, err := _EthereumAccessListItem(txn.AccessList)
+	if err != nil {
+		return nil, err
 	}
 	item.PushBack(accessListItem)
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.RecoveryId))
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"encoding/hex"
 
 	"github.com/pkg/errors"
@@ -18,6 +19,8 @@ type EthereumFlow struct {
 	callDataFileID  *FileID
 	maxGasAllowance *Hbar
 	nodeAccountIDs  []AccountID
+	builder         *EthereumTransactionBuilder
+	signingKey      *PrivateKey
 }
 
 // Execute an Ethereum transaction on Hiero
@@ -43,6 +46,20 @@ func (transaction *EthereumFlow) SetEthereumDataBytes(data []byte) *EthereumFlow
 	return transaction
 }
 
+// SetEthereumTransactionBuilder sets the builder of the Ethereum transaction and the ECDSA key of its sender, which
+// signs the transaction when it is executed. With the EthereumNonceManager of the client the nonce of the builder
+// is taken from the manager, and the transaction is signed again with a fresh nonce if it fails with WRONG_NONCE.
+func (transaction *EthereumFlow) SetEthereumTransactionBuilder(builder *EthereumTransactionBuilder, key PrivateKey) *EthereumFlow {
+	transaction.builder = builder
+	transaction.signingKey = &key
+	return transaction
+}
+
+// GetEthereumTransactionBuilder returns the builder of the Ethereum transaction
+func (transaction *EthereumFlow) GetEthereumTransactionBuilder() *EthereumTransactionBuilder {
+	return transaction.builder
+}
+
 // GetEthreumData  returns the data of the Ethereum transaction
 func (transaction *EthereumFlow) GetEthereumData() *EthereumTransactionData {
 	return transaction.ethereumData
@@ -90,6 +107,10 @@ func (transaction *EthereumFlow) GetNodeAccountIDs() []AccountID {
 }
 
 func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (FileID, error) {
+	return transaction._CreateFileWithContext(context.Background(), callData, client)
+}
+
+func (transaction *EthereumFlow) _CreateFileWithContext(ctx context.Context, callData []byte, client *Client) (FileID, error) {
 	// The calldata in the file needs to be hex encoded
 	callDataHex := []byte(hex.EncodeToString(callData))
 	fileCreate := NewFileCreateTransaction().SetKeys(client.GetOperatorPublicKey())
@@ -100,12 +121,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 	if len(callDataHex) < 4097 {
 		resp, err := fileCreate.
 			SetContents(callDataHex).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 		if err != nil {
 			return FileID{}, err
 		}
 
-		receipt, err := resp.GetReceipt(client)
+		receipt, err := resp.GetReceiptWithContext(ctx, client)
 		if err != nil {
 			return FileID{}, err
 		}
@@ -115,12 +136,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 
 	resp, err := fileCreate.
 		SetContents(callDataHex[:4097]).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
 
-	receipt, err := resp.GetReceipt(client)
+	receipt, err := resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
@@ -131,12 +152,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 		SetFileID(fileID).
 		SetContents(callDataHex[4097:]).
 		SetMaxChunks(1000).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
 
-	_, err = resp.GetReceipt(client)
+	_, err = resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
@@ -146,6 +167,65 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 
 // Execute executes the Transaction with the provided client
 func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping at whichever step is running
+// when ctx is done.
+func (transaction *EthereumFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
+	if transaction.builder != nil {
+		return transaction._ExecuteBuilder(ctx, client)
+	}
+
+	return transaction._Execute(ctx, client)
+}
+
+// _ExecuteBuilder signs the transaction of the builder and executes it, with nonces of the EthereumNonceManager of
+// the client when it has one
+func (transaction *EthereumFlow) _ExecuteBuilder(ctx context.Context, client *Client) (TransactionResponse, error) {
+	if transaction.signingKey.ecdsaPrivateKey == nil {
+		return TransactionResponse{}, errEthereumSignerNotECDSA
+	}
+
+	manager := client.GetEthereumNonceManager()
+	if manager == nil {
+		data, err := transaction.builder.Sign(*transaction.signingKey)
+		if err != nil {
+			return TransactionResponse{}, err
+		}
+		transaction.ethereumData = data
+		return transaction._Execute(ctx, client)
+	}
+
+	sender := transaction.signingKey.PublicKey().ToEvmAddress()
+	maxAttempts := manager.GetMaxAttempts()
+	for attempt := 1; ; attempt++ {
+		nonce, err := manager.NextNonce(ctx, client, sender)
+		if err != nil {
+			return TransactionResponse{}, err
+		}
+
+		data, err := transaction.builder.SetNonce(nonce).Sign(*transaction.signingKey)
+		if err != nil {
+			manager.Resync(sender)
+			return TransactionResponse{}, err
+		}
+		transaction.ethereumData = data
+
+		resp, err := transaction._Execute(ctx, client)
+		if err == nil || _IsEthereumNonceUsed(err) {
+			return resp, err
+		}
+
+		// The nonce was not used, or it is not known whether it was, so the next one is read from the source
+		manager.Resync(sender)
+		if !_IsEthereumNonceError(err) || attempt >= maxAttempts {
+			return resp, err
+		}
+	}
+}
+
+func (transaction *EthereumFlow) _Execute(ctx context.Context, client *Client) (TransactionResponse, error) {
 	if transaction.ethereumData == nil {
 		return TransactionResponse{}, errors.New("cannot submit ethereum transaction with no ethereum data")
 	}
@@ -176,7 +256,7 @@ func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, e
 			SetEthereumData(dataBytes)
 	} else {
 		fileID, err := transaction.
-			_CreateFile(transaction.ethereumData.GetData(), client)
+			_CreateFileWithContext(ctx, transaction.ethereumData.GetData(), client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
@@ -193,12 +273,12 @@ func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, e
 	}
 
 	resp, err := ethereumTransaction.
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return resp, err
 	}
 
-	_, err = resp.GetReceipt(client)
+	_, err = resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return resp, err
 	}
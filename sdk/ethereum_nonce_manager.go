@@ -0,0 +1,198 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// EthereumNonceSource returns the current Ethereum nonce of the account with the EVM address, without the 0x
+// prefix, seeding an EthereumNonceManager.
+type EthereumNonceSource func(ctx context.Context, client *Client, evmAddress string) (uint64, error)
+
+// EthereumNonceFromMirrorNode is the EthereumNonceSource reading the nonce of the account from the mirror node.
+// An account the mirror node does not know yet has the nonce 0.
+func EthereumNonceFromMirrorNode(ctx context.Context, client *Client, evmAddress string) (uint64, error) {
+	account, err := client.GetMirrorNodeClient().GetAccount(ctx, "0x"+evmAddress)
+	if err != nil {
+		var statusErr ErrMirrorNodeStatus
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if account.EthereumNonce < 0 {
+		return 0, errors.Errorf("mirror node returned the negative ethereum nonce %d", account.EthereumNonce)
+	}
+
+	return uint64(account.EthereumNonce), nil
+}
+
+// EthereumNonceFromAccountInfo is the EthereumNonceSource reading the nonce of the account with an
+// AccountInfoQuery, which is not behind the consensus nodes like the mirror node but is paid for by the operator.
+func EthereumNonceFromAccountInfo(ctx context.Context, client *Client, evmAddress string) (uint64, error) {
+	accountID, err := AccountIDFromEvmAddress(client.GetShard(), client.GetRealm(), evmAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	info, err := NewAccountInfoQuery().
+		SetAccountID(accountID).
+		ExecuteWithContext(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+	if info.EthereumNonce < 0 {
+		return 0, errors.Errorf("account info has the negative ethereum nonce %d", info.EthereumNonce)
+	}
+
+	return uint64(info.EthereumNonce), nil
+}
+
+// EthereumNonceManager hands out the Ethereum nonces of senders, so transactions sent concurrently from the same
+// ECDSA alias do not collide. The nonce of a sender is seeded from its EthereumNonceSource the first time it is
+// needed and after every Resync. It is set on the Client with SetEthereumNonceManager, and used by EthereumFlow
+// with SetEthereumTransactionBuilder. It is safe for concurrent use.
+type EthereumNonceManager struct {
+	mu          sync.Mutex
+	source      EthereumNonceSource
+	maxAttempts int
+	senders     map[string]*_EthereumNonceSender
+}
+
+type _EthereumNonceSender struct {
+	mu sync.Mutex
+	// seeded is false until the nonce is read from the source, and again after a Resync
+	seeded bool
+	next   uint64
+}
+
+// NewEthereumNonceManager creates an EthereumNonceManager seeding nonces from the mirror node
+func NewEthereumNonceManager() *EthereumNonceManager {
+	return &EthereumNonceManager{
+		source:      EthereumNonceFromMirrorNode,
+		maxAttempts: 3,
+		senders:     make(map[string]*_EthereumNonceSender),
+	}
+}
+
+// SetNonceSource sets where the nonces of senders are read from, EthereumNonceFromMirrorNode by default
+func (manager *EthereumNonceManager) SetNonceSource(source EthereumNonceSource) *EthereumNonceManager {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if source == nil {
+		source = EthereumNonceFromMirrorNode
+	}
+	manager.source = source
+	return manager
+}
+
+// GetNonceSource returns where the nonces of senders are read from
+func (manager *EthereumNonceManager) GetNonceSource() EthereumNonceSource {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	return manager.source
+}
+
+// SetMaxAttempts sets how many times an EthereumFlow is signed with a fresh nonce and submitted when it fails with
+// a nonce related status, 3 by default
+func (manager *EthereumNonceManager) SetMaxAttempts(maxAttempts int) *EthereumNonceManager {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	manager.maxAttempts = maxAttempts
+	return manager
+}
+
+// GetMaxAttempts returns how many times an EthereumFlow is submitted when it fails with a nonce related status
+func (manager *EthereumNonceManager) GetMaxAttempts() int {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	return manager.maxAttempts
+}
+
+// NextNonce reserves the next nonce of the sender with the EVM address, seeding it from the nonce source when the
+// sender has no nonce yet. Every call returns a different nonce until the sender is resynchronized.
+func (manager *EthereumNonceManager) NextNonce(ctx context.Context, client *Client, evmAddress string) (uint64, error) {
+	evmAddress = _EthereumNonceKey(evmAddress)
+	sender, source := manager._Sender(evmAddress)
+
+	// The sender is locked while it is seeded, so concurrent calls wait for the seed rather than read it twice
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	if !sender.seeded {
+		nonce, err := source(ctx, client, evmAddress)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to read the ethereum nonce of 0x%s", evmAddress)
+		}
+		sender.next = nonce
+		sender.seeded = true
+	}
+
+	nonce := sender.next
+	sender.next++
+	return nonce, nil
+}
+
+// Resync forgets the nonce of the sender with the EVM address, so the next nonce is read from the nonce source
+// again. EthereumFlow resynchronizes the sender when a transaction fails with a nonce related status, or before
+// it is known whether its nonce was used.
+func (manager *EthereumNonceManager) Resync(evmAddress string) {
+	sender, _ := manager._Sender(_EthereumNonceKey(evmAddress))
+
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	sender.seeded = false
+}
+
+func (manager *EthereumNonceManager) _Sender(evmAddress string) (*_EthereumNonceSender, EthereumNonceSource) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	sender, ok := manager.senders[evmAddress]
+	if !ok {
+		sender = &_EthereumNonceSender{}
+		manager.senders[evmAddress] = sender
+	}
+
+	return sender, manager.source
+}
+
+func _EthereumNonceKey(evmAddress string) string {
+	return strings.ToLower(strings.TrimPrefix(evmAddress, "0x"))
+}
+
+// _IsEthereumNonceError reports whether the transaction failed because of its nonce
+func _IsEthereumNonceError(err error) bool {
+	var precheckErr ErrHederaPreCheckStatus
+	if errors.As(err, &precheckErr) {
+		return precheckErr.Status == StatusWrongNonce
+	}
+
+	var receiptErr ErrHederaReceiptStatus
+	if errors.As(err, &receiptErr) {
+		return receiptErr.Status == StatusWrongNonce
+	}
+
+	return false
+}
+
+// _IsEthereumNonceUsed reports whether the nonce of a transaction which failed with the error was used. A
+// transaction which reached consensus uses its nonce even when it fails, unless it failed because of the nonce.
+func _IsEthereumNonceUsed(err error) bool {
+	var receiptErr ErrHederaReceiptStatus
+	return errors.As(err, &receiptErr) && receiptErr.Status != StatusWrongNonce
+}
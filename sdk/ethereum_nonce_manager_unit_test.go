@@ -0,0 +1,215 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestUnitEthereumNonceManagerConcurrent(t *testing.T) {
+	t.Parallel()
+
+	var seeds int32
+	manager := NewEthereumNonceManager().SetNonceSource(func(ctx context.Context, client *Client, evmAddress string) (uint64, error) {
+		atomic.AddInt32(&seeds, 1)
+		assert.Equal(t, "00000000000000000000000000000000000000aa", evmAddress)
+		return 10, nil
+	})
+
+	var mu sync.Mutex
+	nonces := map[uint64]bool{}
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			address := "0x00000000000000000000000000000000000000AA"
+			if i%2 == 0 {
+				address = "00000000000000000000000000000000000000aa"
+			}
+			nonce, err := manager.NextNonce(context.Background(), nil, address)
+			assert.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.False(t, nonces[nonce], "nonce %d handed out twice", nonce)
+			nonces[nonce] = true
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), seeds)
+	for nonce := uint64(10); nonce < 60; nonce++ {
+		assert.True(t, nonces[nonce], "nonce %d not handed out", nonce)
+	}
+}
+
+func TestUnitEthereumNonceManagerResync(t *testing.T) {
+	t.Parallel()
+
+	seed := uint64(3)
+	manager := NewEthereumNonceManager().SetNonceSource(func(ctx context.Context, client *Client, evmAddress string) (uint64, error) {
+		return seed, nil
+	})
+
+	nonce, err := manager.NextNonce(context.Background(), nil, "aa")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), nonce)
+	nonce, err = manager.NextNonce(context.Background(), nil, "aa")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), nonce)
+
+	// Senders have their own nonces
+	nonce, err = manager.NextNonce(context.Background(), nil, "bb")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), nonce)
+
+	seed = 9
+	manager.Resync("0xAA")
+	nonce, err = manager.NextNonce(context.Background(), nil, "aa")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), nonce)
+	nonce, err = manager.NextNonce(context.Background(), nil, "bb")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), nonce)
+}
+
+func TestUnitEthereumNonceManagerSourceError(t *testing.T) {
+	t.Parallel()
+
+	fail := true
+	manager := NewEthereumNonceManager().SetNonceSource(func(ctx context.Context, client *Client, evmAddress string) (uint64, error) {
+		if fail {
+			return 0, errors.New("mirror node is down")
+		}
+		return 1, nil
+	})
+
+	_, err := manager.NextNonce(context.Background(), nil, "aa")
+	require.ErrorContains(t, err, "failed to read the ethereum nonce of 0xaa: mirror node is down")
+
+	fail = false
+	nonce, err := manager.NextNonce(context.Background(), nil, "aa")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+
+	assert.Equal(t, 3, manager.GetMaxAttempts())
+	assert.Equal(t, 1, manager.SetMaxAttempts(0).GetMaxAttempts())
+}
+
+func TestUnitEthereumNonceFromMirrorNode(t *testing.T) {
+	t.Parallel()
+
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/accounts/0x00000000000000000000000000000000000000aa":
+			_, _ = io.WriteString(w, `{"account": "0.0.1234", "ethereum_nonce": 12}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"_status":{"messages":[{"message":"Not found"}]}}`)
+		}
+	})
+
+	nonce, err := EthereumNonceFromMirrorNode(context.Background(), client, "00000000000000000000000000000000000000aa")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), nonce)
+
+	// An account the mirror node does not know has not sent transactions yet
+	nonce, err = EthereumNonceFromMirrorNode(context.Background(), client, "00000000000000000000000000000000000000bb")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), nonce)
+}
+
+func TestUnitEthereumFlowNonceManagerRetriesWrongNonce(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	var submitted []uint64
+	call := func(status services.ResponseCodeEnum) func(request *services.Transaction) *services.TransactionResponse {
+		return func(request *services.Transaction) *services.TransactionResponse {
+			signedTransaction := services.SignedTransaction{}
+			require.NoError(t, protobuf.Unmarshal(request.SignedTransactionBytes, &signedTransaction))
+			transactionBody := services.TransactionBody{}
+			require.NoError(t, protobuf.Unmarshal(signedTransaction.BodyBytes, &transactionBody))
+
+			data, err := EthereumTransactionDataFromBytes(transactionBody.GetEthereumTransaction().GetEthereumData())
+			require.NoError(t, err)
+			sender, err := data.Sender()
+			require.NoError(t, err)
+			require.Equal(t, key.PublicKey().ToEvmAddress(), sender)
+			submitted = append(submitted, new(big.Int).SetBytes(data.eip1559.Nonce).Uint64())
+
+			return &services.TransactionResponse{NodeTransactionPrecheckCode: status}
+		}
+	}
+	receipt := &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{
+					NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+					ResponseType:                services.ResponseType_ANSWER_ONLY,
+				},
+				Receipt: &services.TransactionReceipt{Status: services.ResponseCodeEnum_SUCCESS},
+			},
+		},
+	}
+	responses := [][]interface{}{{
+		call(services.ResponseCodeEnum_WRONG_NONCE), call(services.ResponseCodeEnum_OK), receipt,
+		call(services.ResponseCodeEnum_OK), receipt,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	seeds := []uint64{4, 6}
+	client.SetEthereumNonceManager(NewEthereumNonceManager().
+		SetNonceSource(func(ctx context.Context, client *Client, evmAddress string) (uint64, error) {
+			seed := seeds[0]
+			seeds = seeds[1:]
+			return seed, nil
+		}))
+
+	builder := _TestEthereumBuilder(EthereumTransactionTypeEIP1559)
+	_, err = NewEthereumFlow().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetEthereumTransactionBuilder(builder, key).
+		Execute(client)
+	require.NoError(t, err)
+
+	// The sender is resynchronized after WRONG_NONCE, then the nonces go on from the new seed
+	_, err = NewEthereumFlow().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetEthereumTransactionBuilder(builder, key).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4, 6, 7}, submitted)
+}
+
+func TestUnitEthereumFlowBuilderRequiresECDSA(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	_, err = NewEthereumFlow().
+		SetEthereumTransactionBuilder(_TestEthereumBuilder(EthereumTransactionTypeEIP1559), key).
+		Execute(nil)
+	require.ErrorIs(t, err, errEthereumSignerNotECDSA)
+}
@@ -0,0 +1,471 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/pkg/errors"
+)
+
+// EthereumTransactionType is the type of an Ethereum transaction
+type EthereumTransactionType int
+
+const (
+	// EthereumTransactionTypeLegacy is a legacy transaction, replay protected by EIP-155 when it has a chain ID
+	EthereumTransactionTypeLegacy EthereumTransactionType = 0
+	// EthereumTransactionTypeEIP2930 is an EIP-2930 transaction, with an access list
+	EthereumTransactionTypeEIP2930 EthereumTransactionType = 1
+	// EthereumTransactionTypeEIP1559 is an EIP-1559 transaction, with a priority fee and a max fee
+	EthereumTransactionTypeEIP1559 EthereumTransactionType = 2
+)
+
+// String returns the name of the transaction type
+func (transactionType EthereumTransactionType) String() string {
+	switch transactionType {
+	case EthereumTransactionTypeLegacy:
+		return "LEGACY"
+	case EthereumTransactionTypeEIP2930:
+		return "EIP2930"
+	case EthereumTransactionTypeEIP1559:
+		return "EIP1559"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// EthereumAccessListEntry is an address, and storage keys of it, which an EIP-2930 or EIP-1559 transaction
+// declares it accesses
+type EthereumAccessListEntry struct {
+	Address     []byte
+	StorageKeys [][]byte
+}
+
+// ToBytes returns the RLP encoding of the entry, an element of the AccessList of EthereumEIP2930Transaction and
+// EthereumEIP1559Transaction
+func (entry EthereumAccessListEntry) ToBytes() ([]byte, error) {
+	return entry._ToRLPItem().Write()
+}
+
+func (entry EthereumAccessListEntry) _ToRLPItem() *RLPItem {
+	storageKeys := NewRLPItem(LIST_TYPE)
+	for _, storageKey := range entry.StorageKeys {
+		key := NewRLPItem(VALUE_TYPE)
+		key.AssignBytes(storageKey)
+		storageKeys.PushBack(key)
+	}
+
+	address := NewRLPItem(VALUE_TYPE)
+	address.AssignBytes(entry.Address)
+
+	item := NewRLPItem(LIST_TYPE)
+	item.PushBack(address)
+	item.PushBack(storageKeys)
+	return item
+}
+
+// EthereumAccessListEntryFromBytes decodes the RLP encoding of an access list entry
+func EthereumAccessListEntryFromBytes(bytes []byte) (EthereumAccessListEntry, error) {
+	item := NewRLPItem(LIST_TYPE)
+	if err := item.Read(bytes); err != nil {
+		return EthereumAccessListEntry{}, errors.Wrap(err, "failed to read RLP data")
+	}
+
+	if item.itemType != LIST_TYPE || len(item.childItems) != 2 || item.childItems[1].itemType != LIST_TYPE {
+		return EthereumAccessListEntry{}, errors.New("access list entry should be a list of an address and a list of storage keys")
+	}
+
+	entry := EthereumAccessListEntry{Address: item.childItems[0].itemValue}
+	for _, storageKey := range item.childItems[1].childItems {
+		entry.StorageKeys = append(entry.StorageKeys, storageKey.itemValue)
+	}
+
+	return entry, nil
+}
+
+// EthereumTransactionBuilder builds Ethereum transactions and signs them with an ECDSA secp256k1 key, for
+// EthereumTransaction and EthereumFlow
+type EthereumTransactionBuilder struct {
+	transactionType EthereumTransactionType
+	chainID         uint64
+	nonce           uint64
+	gasPrice        *big.Int
+	maxPriorityGas  *big.Int
+	maxGas          *big.Int
+	gasLimit        uint64
+	to              []byte
+	value           *big.Int
+	callData        []byte
+	accessList      []EthereumAccessListEntry
+}
+
+// NewEthereumTransactionBuilder creates an EthereumTransactionBuilder of the transaction type
+func NewEthereumTransactionBuilder(transactionType EthereumTransactionType) *EthereumTransactionBuilder {
+	return &EthereumTransactionBuilder{transactionType: transactionType}
+}
+
+// SetChainID sets the chain ID of the network, 295 for mainnet, 296 for testnet and 297 for previewnet. A legacy
+// transaction without a chain ID is not replay protected.
+func (builder *EthereumTransactionBuilder) SetChainID(chainID uint64) *EthereumTransactionBuilder {
+	builder.chainID = chainID
+	return builder
+}
+
+// GetChainID returns the chain ID of the network
+func (builder *EthereumTransactionBuilder) GetChainID() uint64 {
+	return builder.chainID
+}
+
+// SetNonce sets the nonce of the sender
+func (builder *EthereumTransactionBuilder) SetNonce(nonce uint64) *EthereumTransactionBuilder {
+	builder.nonce = nonce
+	return builder
+}
+
+// GetNonce returns the nonce of the sender
+func (builder *EthereumTransactionBuilder) GetNonce() uint64 {
+	return builder.nonce
+}
+
+// SetGasPrice sets the gas price in weibars of a legacy or EIP-2930 transaction
+func (builder *EthereumTransactionBuilder) SetGasPrice(gasPrice *big.Int) *EthereumTransactionBuilder {
+	builder.gasPrice = gasPrice
+	return builder
+}
+
+// GetGasPrice returns the gas price in weibars of a legacy or EIP-2930 transaction
+func (builder *EthereumTransactionBuilder) GetGasPrice() *big.Int {
+	return builder.gasPrice
+}
+
+// SetMaxPriorityGas sets the max priority fee per gas in weibars of an EIP-1559 transaction
+func (builder *EthereumTransactionBuilder) SetMaxPriorityGas(maxPriorityGas *big.Int) *EthereumTransactionBuilder {
+	builder.maxPriorityGas = maxPriorityGas
+	return builder
+}
+
+// GetMaxPriorityGas returns the max priority fee per gas in weibars of an EIP-1559 transaction
+func (builder *EthereumTransactionBuilder) GetMaxPriorityGas() *big.Int {
+	return builder.maxPriorityGas
+}
+
+// SetMaxGas sets the max fee per gas in weibars of an EIP-1559 transaction
+func (builder *EthereumTransactionBuilder) SetMaxGas(maxGas *big.Int) *EthereumTransactionBuilder {
+	builder.maxGas = maxGas
+	return builder
+}
+
+// GetMaxGas returns the max fee per gas in weibars of an EIP-1559 transaction
+func (builder *EthereumTransactionBuilder) GetMaxGas() *big.Int {
+	return builder.maxGas
+}
+
+// SetGasLimit sets the gas limit of the transaction
+func (builder *EthereumTransactionBuilder) SetGasLimit(gasLimit uint64) *EthereumTransactionBuilder {
+	builder.gasLimit = gasLimit
+	return builder
+}
+
+// GetGasLimit returns the gas limit of the transaction
+func (builder *EthereumTransactionBuilder) GetGasLimit() uint64 {
+	return builder.gasLimit
+}
+
+// SetTo sets the 20 byte EVM address of the receiver. Without it the transaction creates a contract.
+func (builder *EthereumTransactionBuilder) SetTo(to []byte) *EthereumTransactionBuilder {
+	builder.to = to
+	return builder
+}
+
+// GetTo returns the EVM address of the receiver
+func (builder *EthereumTransactionBuilder) GetTo() []byte {
+	return builder.to
+}
+
+// SetValue sets the value in weibars sent to the receiver
+func (builder *EthereumTransactionBuilder) SetValue(value *big.Int) *EthereumTransactionBuilder {
+	builder.value = value
+	return builder
+}
+
+// GetValue returns the value in weibars sent to the receiver
+func (builder *EthereumTransactionBuilder) GetValue() *big.Int {
+	return builder.value
+}
+
+// SetCallData sets the call data of the transaction, or the init code of the contract it creates
+func (builder *EthereumTransactionBuilder) SetCallData(callData []byte) *EthereumTransactionBuilder {
+	builder.callData = callData
+	return builder
+}
+
+// GetCallData returns the call data of the transaction
+func (builder *EthereumTransactionBuilder) GetCallData() []byte {
+	return builder.callData
+}
+
+// SetAccessList sets the access list of an EIP-2930 or EIP-1559 transaction
+func (builder *EthereumTransactionBuilder) SetAccessList(accessList []EthereumAccessListEntry) *EthereumTransactionBuilder {
+	builder.accessList = accessList
+	return builder
+}
+
+// GetAccessList returns the access list of an EIP-2930 or EIP-1559 transaction
+func (builder *EthereumTransactionBuilder) GetAccessList() []EthereumAccessListEntry {
+	return builder.accessList
+}
+
+// SigningHash returns the Keccak256 hash of the transaction which its sender signs
+func (builder *EthereumTransactionBuilder) SigningHash() (Hash, error) {
+	transaction, err := builder._Unsigned()
+	if err != nil {
+		return Hash{}, err
+	}
+
+	preimage, err := _EthereumSigningPreimage(transaction)
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash(preimage), nil
+}
+
+// Sign signs the transaction with the ECDSA secp256k1 key of the sender. The signed transaction can be set on
+// EthereumFlow.SetEthereumData or, with ToBytes, on EthereumTransaction.SetEthereumData.
+func (builder *EthereumTransactionBuilder) Sign(key PrivateKey) (*EthereumTransactionData, error) {
+	if key.ecdsaPrivateKey == nil {
+		return nil, errEthereumSignerNotECDSA
+	}
+
+	transaction, err := builder._Unsigned()
+	if err != nil {
+		return nil, err
+	}
+
+	preimage, err := _EthereumSigningPreimage(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := key.Sign(preimage)
+	r := append([]byte{}, signature[:32]...)
+	s := append([]byte{}, signature[32:]...)
+	recoveryID := key.GetRecoveryId(append([]byte{}, r...), s, preimage)
+	if recoveryID < 0 {
+		return nil, errEthereumSignatureInvalid
+	}
+
+	r = new(big.Int).SetBytes(r).Bytes()
+	s = new(big.Int).SetBytes(s).Bytes()
+
+	switch {
+	case transaction.legacy != nil:
+		v := uint64(27 + recoveryID)
+		if builder.chainID != 0 {
+			v = builder.chainID*2 + 35 + uint64(recoveryID)
+		}
+		transaction.legacy.V = encodeBinary(v)
+		transaction.legacy.R = r
+		transaction.legacy.S = s
+	case transaction.eip2930 != nil:
+		transaction.eip2930.RecoveryId = encodeBinary(uint64(recoveryID))
+		transaction.eip2930.R = r
+		transaction.eip2930.S = s
+	default:
+		transaction.eip1559.RecoveryId = encodeBinary(uint64(recoveryID))
+		transaction.eip1559.R = r
+		transaction.eip1559.S = s
+	}
+
+	return transaction, nil
+}
+
+func (builder *EthereumTransactionBuilder) _Unsigned() (*EthereumTransactionData, error) {
+	if len(builder.to) != 0 && len(builder.to) != 20 {
+		return nil, errEvmAddressIsNotCorrectSize
+	}
+
+	accessList := make([][]byte, 0, len(builder.accessList))
+	for _, entry := range builder.accessList {
+		entryBytes, err := entry.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		accessList = append(accessList, entryBytes)
+	}
+
+	chainID := encodeBinary(builder.chainID)
+	nonce := encodeBinary(builder.nonce)
+	gasLimit := encodeBinary(builder.gasLimit)
+
+	switch builder.transactionType {
+	case EthereumTransactionTypeLegacy:
+		if len(accessList) > 0 {
+			return nil, errors.New("legacy ethereum transactions have no access list")
+		}
+
+		// Until it is signed, V carries the chain ID the transaction is signed for
+		var v []byte
+		if builder.chainID != 0 {
+			v = encodeBinary(builder.chainID*2 + 35)
+		}
+		return &EthereumTransactionData{legacy: NewEthereumLegacyTransaction(
+			nonce, _BigIntBytes(builder.gasPrice), gasLimit, builder.to, _BigIntBytes(builder.value), builder.callData,
+			v, nil, nil,
+		)}, nil
+	case EthereumTransactionTypeEIP2930:
+		return &EthereumTransactionData{eip2930: NewEthereumEIP2930Transaction(
+			chainID, nonce, _BigIntBytes(builder.gasPrice), gasLimit, builder.to, _BigIntBytes(builder.value),
+			builder.callData, nil, nil, nil, accessList,
+		)}, nil
+	case EthereumTransactionTypeEIP1559:
+		return &EthereumTransactionData{eip1559: NewEthereumEIP1559Transaction(
+			chainID, nonce, _BigIntBytes(builder.maxPriorityGas), _BigIntBytes(builder.maxGas), gasLimit, builder.to,
+			_BigIntBytes(builder.value), builder.callData, nil, nil, nil, accessList,
+		)}, nil
+	default:
+		return nil, errors.Errorf("unknown ethereum transaction type %d", builder.transactionType)
+	}
+}
+
+// _EthereumSigningPreimage returns the bytes whose Keccak256 hash the sender of the transaction signs
+func _EthereumSigningPreimage(transaction *EthereumTransactionData) ([]byte, error) {
+	item := NewRLPItem(LIST_TYPE)
+	value := func(value []byte) {
+		item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(value))
+	}
+
+	switch {
+	case transaction.legacy != nil:
+		legacy := transaction.legacy
+		value(legacy.Nonce)
+		value(legacy.GasPrice)
+		value(legacy.GasLimit)
+		value(legacy.To)
+		value(legacy.Value)
+		value(legacy.CallData)
+
+		// EIP-155 signs the chain ID in place of the signature
+		chainID, _, err := _EthereumLegacyChainID(legacy.V)
+		if err != nil {
+			return nil, err
+		}
+		if chainID != nil {
+			value(chainID.Bytes())
+			value(nil)
+			value(nil)
+		}
+
+		return item.Write()
+	case transaction.eip2930 != nil:
+		eip2930 := transaction.eip2930
+		value(eip2930.ChainId)
+		value(eip2930.Nonce)
+		value(eip2930.GasPrice)
+		value(eip2930.GasLimit)
+		value(eip2930.To)
+		value(eip2930.Value)
+		value(eip2930.CallData)
+		accessList, err := _EthereumAccessListItem(eip2930.AccessList)
+		if err != nil {
+			return nil, err
+		}
+		item.PushBack(accessList)
+
+		encoded, err := item.Write()
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{0x01}, encoded...), nil
+	case transaction.eip1559 != nil:
+		eip1559 := transaction.eip1559
+		value(eip1559.ChainId)
+		value(eip1559.Nonce)
+		value(eip1559.MaxPriorityGas)
+		value(eip1559.MaxGas)
+		value(eip1559.GasLimit)
+		value(eip1559.To)
+		value(eip1559.Value)
+		value(eip1559.CallData)
+		accessList, err := _EthereumAccessListItem(eip1559.AccessList)
+		if err != nil {
+			return nil, err
+		}
+		item.PushBack(accessList)
+
+		encoded, err := item.Write()
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{0x02}, encoded...), nil
+	default:
+		return nil, errors.New("ethereum transaction data is empty")
+	}
+}
+
+// _EthereumLegacyChainID returns the chain ID and the recovery ID encoded in the V of a legacy transaction. The
+// chain ID is nil for transactions signed before or without EIP-155.
+func _EthereumLegacyChainID(v []byte) (*big.Int, int, error) {
+	value := new(big.Int).SetBytes(v)
+	if value.Sign() == 0 {
+		return nil, 0, nil
+	}
+	if value.Cmp(big.NewInt(27)) == 0 || value.Cmp(big.NewInt(28)) == 0 {
+		return nil, int(value.Int64() - 27), nil
+	}
+	if value.Cmp(big.NewInt(35)) < 0 {
+		return nil, 0, errors.Errorf("invalid legacy ethereum transaction V %s", value)
+	}
+
+	value.Sub(value, big.NewInt(35))
+	recoveryID := int(value.Bit(0))
+	return value.Rsh(value, 1), recoveryID, nil
+}
+
+// _EthereumAccessListItem returns the RLP list of the RLP encoded access list entries
+func _EthereumAccessListItem(accessList [][]byte) (*RLPItem, error) {
+	item := NewRLPItem(LIST_TYPE)
+	for _, entryBytes := range accessList {
+		entry := NewRLPItem(LIST_TYPE)
+		if err := entry.Read(entryBytes); err != nil {
+			return nil, errors.Wrap(err, "failed to read access list entry")
+		}
+		item.PushBack(entry)
+	}
+
+	return item, nil
+}
+
+// _EthereumRecoverSender returns the public key which signed the transaction
+func _EthereumRecoverSender(transaction *EthereumTransactionData, recoveryID int, r []byte, s []byte) (PublicKey, error) {
+	preimage, err := _EthereumSigningPreimage(transaction)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	if recoveryID < 0 || recoveryID > 1 || len(r) > 32 || len(s) > 32 {
+		return PublicKey{}, errEthereumSignatureInvalid
+	}
+
+	signature := make([]byte, 65)
+	signature[0] = byte(27 + 4 + recoveryID)
+	copy(signature[33-len(r):33], r)
+	copy(signature[65-len(s):], s)
+
+	hash := Keccak256Hash(preimage)
+	key, _, err := ecdsa.RecoverCompact(signature, hash.Bytes())
+	if err != nil {
+		return PublicKey{}, errors.Wrap(errEthereumSignatureInvalid, err.Error())
+	}
+
+	return PublicKey{ecdsaPublicKey: &_ECDSAPublicKey{key}}, nil
+}
+
+func _BigIntBytes(value *big.Int) []byte {
+	if value == nil {
+		return nil
+	}
+
+	return value.Bytes()
+}
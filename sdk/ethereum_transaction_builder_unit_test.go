@@ -0,0 +1,160 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestEthereumBuilder(transactionType EthereumTransactionType) *EthereumTransactionBuilder {
+	return NewEthereumTransactionBuilder(transactionType).
+		SetChainID(296).
+		SetNonce(7).
+		SetGasPrice(big.NewInt(710_000_000_000)).
+		SetMaxPriorityGas(big.NewInt(1)).
+		SetMaxGas(big.NewInt(710_000_000_000)).
+		SetGasLimit(300_000).
+		SetTo(bytes.Repeat([]byte{0x35}, 20)).
+		SetValue(big.NewInt(1_000)).
+		SetCallData([]byte{0x12, 0x34})
+}
+
+func TestUnitEthereumTransactionBuilderEIP155Vector(t *testing.T) {
+	t.Parallel()
+
+	// The example transaction of EIP-155
+	key, err := PrivateKeyFromBytesECDSA(bytes.Repeat([]byte{0x46}, 32))
+	require.NoError(t, err)
+	value, _ := new(big.Int).SetString("1000000000000000000", 10)
+
+	builder := NewEthereumTransactionBuilder(EthereumTransactionTypeLegacy).
+		SetChainID(1).
+		SetNonce(9).
+		SetGasPrice(big.NewInt(20_000_000_000)).
+		SetGasLimit(21_000).
+		SetTo(bytes.Repeat([]byte{0x35}, 20)).
+		SetValue(value)
+
+	hash, err := builder.SigningHash()
+	require.NoError(t, err)
+	assert.Equal(t, "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53", hex.EncodeToString(hash.Bytes()))
+
+	transaction, err := builder.Sign(key)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{37}, transaction.legacy.V)
+	assert.Equal(t, "28ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276", hex.EncodeToString(transaction.legacy.R))
+	assert.Equal(t, "67cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83", hex.EncodeToString(transaction.legacy.S))
+
+	signingHash, err := transaction.SigningHash()
+	require.NoError(t, err)
+	assert.Equal(t, hash, signingHash)
+}
+
+func TestUnitEthereumTransactionBuilderSignAndRecover(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	accessList := []EthereumAccessListEntry{{
+		Address:     bytes.Repeat([]byte{0x11}, 20),
+		StorageKeys: [][]byte{bytes.Repeat([]byte{0x22}, 32), bytes.Repeat([]byte{0x33}, 32)},
+	}}
+
+	for _, builder := range []*EthereumTransactionBuilder{
+		_TestEthereumBuilder(EthereumTransactionTypeLegacy),
+		_TestEthereumBuilder(EthereumTransactionTypeLegacy).SetChainID(0),
+		_TestEthereumBuilder(EthereumTransactionTypeEIP2930).SetAccessList(accessList),
+		_TestEthereumBuilder(EthereumTransactionTypeEIP1559).SetAccessList(accessList),
+		_TestEthereumBuilder(EthereumTransactionTypeEIP1559).SetTo(nil),
+	} {
+		transaction, err := builder.Sign(key)
+		require.NoError(t, err)
+
+		// Recover the sender from the parsed transaction
+		transactionBytes, err := transaction.ToBytes()
+		require.NoError(t, err)
+		parsed, err := EthereumTransactionDataFromBytes(transactionBytes)
+		require.NoError(t, err)
+
+		sender, err := parsed.Sender()
+		require.NoError(t, err, builder.transactionType.String())
+		assert.Equal(t, key.PublicKey().ToEvmAddress(), sender, builder.transactionType.String())
+
+		hash, err := builder.SigningHash()
+		require.NoError(t, err)
+		parsedHash, err := parsed.SigningHash()
+		require.NoError(t, err)
+		assert.Equal(t, hash, parsedHash)
+	}
+}
+
+func TestUnitEthereumTransactionBuilderAccessListRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	entry := EthereumAccessListEntry{
+		Address:     bytes.Repeat([]byte{0x11}, 20),
+		StorageKeys: [][]byte{bytes.Repeat([]byte{0x22}, 32)},
+	}
+	entryBytes, err := entry.ToBytes()
+	require.NoError(t, err)
+
+	decodedEntry, err := EthereumAccessListEntryFromBytes(entryBytes)
+	require.NoError(t, err)
+	assert.Equal(t, entry, decodedEntry)
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	transaction, err := _TestEthereumBuilder(EthereumTransactionTypeEIP2930).
+		SetAccessList([]EthereumAccessListEntry{entry}).
+		Sign(key)
+	require.NoError(t, err)
+
+	transactionBytes, err := transaction.ToBytes()
+	require.NoError(t, err)
+	parsed, err := EthereumEIP2930TransactionFromBytes(transactionBytes)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{entryBytes}, parsed.AccessList)
+
+	reencoded, err := parsed.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, transactionBytes, reencoded)
+}
+
+func TestUnitEthereumTransactionBuilderErrors(t *testing.T) {
+	t.Parallel()
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	_, err = _TestEthereumBuilder(EthereumTransactionTypeEIP1559).Sign(ed25519Key)
+	require.ErrorIs(t, err, errEthereumSignerNotECDSA)
+
+	_, err = _TestEthereumBuilder(EthereumTransactionTypeEIP1559).SetTo([]byte{1, 2, 3}).SigningHash()
+	require.ErrorIs(t, err, errEvmAddressIsNotCorrectSize)
+
+	_, err = _TestEthereumBuilder(EthereumTransactionTypeLegacy).
+		SetAccessList([]EthereumAccessListEntry{{Address: make([]byte, 20)}}).
+		SigningHash()
+	require.Error(t, err)
+
+	_, err = _TestEthereumBuilder(EthereumTransactionType(3)).SigningHash()
+	require.ErrorContains(t, err, "unknown ethereum transaction type 3")
+
+	// An unsigned transaction has no sender
+	unsigned, err := _TestEthereumBuilder(EthereumTransactionTypeLegacy).SetChainID(0)._Unsigned()
+	require.NoError(t, err)
+	_, err = unsigned.Sender()
+	require.ErrorIs(t, err, errEthereumSignatureInvalid)
+
+	_, err = EthereumAccessListEntryFromBytes([]byte{0x80})
+	require.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package hiero
 
 import (
+	"math/big"
+
 	"github.com/pkg/errors"
 )
 
@@ -87,3 +89,46 @@ func (ethereumTxData *EthereumTransactionData) SetData(data []byte) *EthereumTra
 	ethereumTxData.legacy.CallData = data
 	return ethereumTxData
 }
+
+// SigningHash returns the Keccak256 hash of the transaction which its sender signed
+func (ethereumTxData *EthereumTransactionData) SigningHash() (Hash, error) {
+	preimage, err := _EthereumSigningPreimage(ethereumTxData)
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash(preimage), nil
+}
+
+// SenderPublicKey recovers the ECDSA secp256k1 public key which signed the transaction
+func (ethereumTxData *EthereumTransactionData) SenderPublicKey() (PublicKey, error) {
+	switch {
+	case ethereumTxData.eip1559 != nil:
+		return _EthereumRecoverSender(ethereumTxData, int(new(big.Int).SetBytes(ethereumTxData.eip1559.RecoveryId).Int64()),
+			ethereumTxData.eip1559.R, ethereumTxData.eip1559.S)
+	case ethereumTxData.eip2930 != nil:
+		return _EthereumRecoverSender(ethereumTxData, int(new(big.Int).SetBytes(ethereumTxData.eip2930.RecoveryId).Int64()),
+			ethereumTxData.eip2930.R, ethereumTxData.eip2930.S)
+	case ethereumTxData.legacy != nil:
+		if len(ethereumTxData.legacy.V) == 0 {
+			return PublicKey{}, errEthereumSignatureInvalid
+		}
+		_, recoveryID, err := _EthereumLegacyChainID(ethereumTxData.legacy.V)
+		if err != nil {
+			return PublicKey{}, err
+		}
+		return _EthereumRecoverSender(ethereumTxData, recoveryID, ethereumTxData.legacy.R, ethereumTxData.legacy.S)
+	}
+
+	return PublicKey{}, errors.New("transaction data is empty")
+}
+
+// Sender recovers the EVM address, without the 0x prefix, of the account which signed the transaction
+func (ethereumTxData *EthereumTransactionData) Sender() (string, error) {
+	publicKey, err := ethereumTxData.SenderPublicKey()
+	if err != nil {
+		return "", err
+	}
+
+	return publicKey.ToEvmAddress(), nil
+}
@@ -16,6 +16,19 @@ type ExchangeRate struct {
 	expirationTime *services.TimestampSeconds
 }
 
+// NewExchangeRate creates an ExchangeRate where hbars are worth cents US cents.
+func NewExchangeRate(hbars int32, cents int32) ExchangeRate {
+	return ExchangeRate{
+		Hbars: hbars,
+		cents: cents,
+	}
+}
+
+// GetCents returns the US cents the Hbars of the ExchangeRate are worth.
+func (exchange *ExchangeRate) GetCents() int32 {
+	return exchange.cents
+}
+
 func _ExchangeRateFromProtobuf(protoExchange *services.ExchangeRate) ExchangeRate {
 	if protoExchange == nil {
 		return ExchangeRate{}
@@ -36,6 +36,7 @@ type Executable interface {
 	GetMaxRetry() int
 	GetNodeAccountIDs() []AccountID
 	GetLogLevel() *LogLevel
+	GetRetryPolicy() RetryPolicy
 
 	shouldRetry(Executable, interface{}) _ExecutionState
 	makeRequest() interface{}
@@ -61,6 +62,7 @@ type executable struct {
 	grpcDeadline   *time.Duration
 	maxRetry       int
 	logLevel       *LogLevel
+	retryPolicy    RetryPolicy
 }
 
 type _Method struct {
@@ -161,6 +163,16 @@ func (e *executable) SetLogLevel(level LogLevel) *executable {
 	return e
 }
 
+// GetRetryPolicy returns the retry policy of this request, nil when the client's policy is used.
+func (e *executable) GetRetryPolicy() RetryPolicy {
+	return e.retryPolicy
+}
+
+func (e *executable) SetRetryPolicy(policy RetryPolicy) *executable {
+	e.retryPolicy = policy
+	return e
+}
+
 func (e *executable) getLogger(clientLogger Logger) Logger {
 	if e.logLevel != nil {
 		return clientLogger.SubLoggerWithLevel(*e.logLevel)
@@ -173,7 +185,11 @@ func (e *executable) getNodeAccountID() AccountID {
 }
 
 // nolint
-func _Execute(client *Client, e Executable) (interface{}, error) {
+func _Execute(ctx context.Context, client *Client, e Executable) (interface{}, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	var maxAttempts int
 
 	if client.maxAttempts != nil {
@@ -182,8 +198,42 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 		maxAttempts = e.GetMaxRetry()
 	}
 
+	observer := client._GetExecutionObserver()
+	executionID, name, requestID := _NextExecutionID(), e.getName(), e.getLogID(e)
+	start := time.Now()
+
+	observer.OnExecutionStart(ExecutionStartEvent{
+		ExecutionID:   executionID,
+		Name:          name,
+		RequestID:     requestID,
+		IsTransaction: e.isTransaction(),
+		MaxAttempts:   maxAttempts,
+		Context:       ctx,
+	})
+
+	resp, err := _ExecuteAttempts(ctx, client, e, maxAttempts, observer, executionID)
+
+	observer.OnExecutionEnd(ExecutionEndEvent{
+		ExecutionID: executionID,
+		Name:        name,
+		RequestID:   requestID,
+		Duration:    time.Since(start),
+		Err:         err,
+	})
+
+	return resp, err
+}
+
+func _ExecuteAttempts(ctx context.Context, client *Client, e Executable, maxAttempts int, observer ExecutionObserver, executionID uint64) (interface{}, error) {
+	name := e.getName()
 	currentBackoff := e.GetMinBackoff()
 
+	retryPolicy := e.GetRetryPolicy()
+	if retryPolicy == nil {
+		retryPolicy = client.retryPolicy
+	}
+	start := time.Now()
+
 	var attempt int64
 	var errPersistent error
 	var marshaledRequest []byte
@@ -195,6 +245,13 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 		var protoRequest interface{}
 		var node *_Node
 		var ok bool
+		var err error
+
+		if ctx.Err() != nil {
+			return _ExecutableInterrupted(e, ctx, attempt, maxAttempts, errPersistent)
+		}
+
+		observer.OnAttemptStart(AttemptStartEvent{ExecutionID: executionID, Name: name, RequestID: e.getLogID(e), Attempt: attempt + 1})
 
 		// If this is not the first attempt, double the backoff time up to the max backoff time
 		if attempt > 0 && currentBackoff <= e.GetMaxBackoff() {
@@ -212,7 +269,9 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 			return TransactionResponse{}, errBatchedAndNotBatchTransaction
 		}
 		if len(e.GetNodeAccountIDs()) == 0 {
-			node = client.network._GetNode()
+			if node, err = client.network._GetNode(); err != nil {
+				return TransactionResponse{}, err
+			}
 		} else {
 			nodeAccountID := e.getNodeAccountID()
 			if node, ok = client.network._GetNodeForAccountID(nodeAccountID); !ok {
@@ -228,11 +287,24 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 
 		node._InUse()
 
+		observer.OnNodeChosen(NodeChosenEvent{
+			ExecutionID:   executionID,
+			Name:          name,
+			RequestID:     e.getLogID(e),
+			Attempt:       attempt + 1,
+			NodeAccountID: node.accountID,
+			Address:       node._GetAddress(),
+			Healthy:       node._IsHealthy(),
+		})
+
 		txLogger.Trace("executing", "requestId", e.getLogID(e), "nodeAccountID", node.accountID.String(), "nodeIPAddress", node.address._String(), "Request Proto", hex.EncodeToString(marshaledRequest))
 
 		if !node._IsHealthy() {
 			txLogger.Trace("node is unhealthy, waiting before continuing", "requestId", e.getLogID(e), "delay", node._Wait().String())
-			_DelayForAttempt(e.getLogID(e), currentBackoff, attempt, txLogger, errNodeIsUnhealthy)
+			observer.OnBackoff(BackoffEvent{ExecutionID: executionID, Name: name, RequestID: e.getLogID(e), Attempt: attempt + 1, NodeAccountID: node.accountID, Backoff: currentBackoff, Err: errNodeIsUnhealthy})
+			if err := _DelayForAttempt(ctx, e.getLogID(e), currentBackoff, attempt, txLogger, errNodeIsUnhealthy); err != nil {
+				return _ExecutableInterrupted(e, ctx, attempt, maxAttempts, errNodeIsUnhealthy)
+			}
 			continue
 		}
 
@@ -240,6 +312,7 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 		channel, err := node._GetChannel(txLogger)
 		if err != nil {
 			client.network._IncreaseBackoff(node)
+			observer.OnNodeUnhealthy(_NodeUnhealthyEvent(executionID, name, e.getLogID(e), node, err))
 			errPersistent = err
 			continue
 		}
@@ -250,36 +323,82 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 
 		var resp interface{}
 
-		ctx := context.Background()
+		grpcCtx := ctx
 		var cancel context.CancelFunc
 
 		if e.GetGrpcDeadline() != nil {
 			grpcDeadline := time.Now().Add(*e.GetGrpcDeadline())
-			ctx, cancel = context.WithDeadline(ctx, grpcDeadline)
+			grpcCtx, cancel = context.WithDeadline(ctx, grpcDeadline)
 		}
 
 		txLogger.Trace("executing gRPC call", "requestId", e.getLogID(e))
 
 		var marshaledResponse []byte
+		callStart := time.Now()
+		node._StartRequest()
 		if method.query != nil {
-			resp, err = method.query(ctx, protoRequest.(*services.Query))
+			resp, err = method.query(grpcCtx, protoRequest.(*services.Query))
 			if err == nil {
 				marshaledResponse, _ = protobuf.Marshal(resp.(*services.Response))
 			}
 		} else {
-			resp, err = method.transaction(ctx, protoRequest.(*services.Transaction))
+			resp, err = method.transaction(grpcCtx, protoRequest.(*services.Transaction))
 			if err == nil {
 				marshaledResponse, _ = protobuf.Marshal(resp.(*services.TransactionResponse))
 			}
 		}
 
+		callLatency := time.Since(callStart)
+		node._EndRequest()
+		if err == nil {
+			node._RecordLatency(callLatency)
+		}
+
 		if cancel != nil {
 			cancel()
 		}
+		observer.OnGrpcCall(GrpcCallEvent{
+			ExecutionID:   executionID,
+			Name:          name,
+			RequestID:     e.getLogID(e),
+			Attempt:       attempt + 1,
+			NodeAccountID: node.accountID,
+			Latency:       callLatency,
+			Code:          status.Code(err),
+			Err:           err,
+		})
 		if err != nil {
 			errPersistent = err
-			if _ExecutableDefaultRetryHandler(e.getLogID(e), err, txLogger) {
+			// The caller's context was cancelled or its deadline passed while the call was in flight,
+			// this is not a node failure so the node's backoff is left untouched.
+			if ctx.Err() != nil {
+				return _ExecutableInterrupted(e, ctx, attempt, maxAttempts, errPersistent)
+			}
+			retryable := _ExecutableDefaultRetryHandler(e.getLogID(e), err, txLogger)
+			decision := RetryDecision{Retry: retryable, SwitchNode: retryable}
+			if retryPolicy != nil {
+				decision = retryPolicy.Decide(RetryContext{
+					Attempt:       attempt + 1,
+					MaxAttempts:   maxAttempts,
+					Elapsed:       time.Since(start),
+					NodeAccountID: node.accountID,
+					GrpcCode:      status.Code(err),
+					Status:        StatusOk,
+					Err:           err,
+					Default:       decision,
+				})
+			}
+			if decision.SwitchNode {
 				client.network._IncreaseBackoff(node)
+				observer.OnNodeUnhealthy(_NodeUnhealthyEvent(executionID, name, e.getLogID(e), node, err))
+			}
+			if decision.Retry {
+				if decision.Backoff > 0 {
+					observer.OnBackoff(BackoffEvent{ExecutionID: executionID, Name: name, RequestID: e.getLogID(e), Attempt: attempt + 1, NodeAccountID: node.accountID, Backoff: decision.Backoff, Err: errPersistent})
+					if err := _DelayForAttempt(ctx, e.getLogID(e), decision.Backoff, attempt, txLogger, errPersistent); err != nil {
+						return _ExecutableInterrupted(e, ctx, attempt, maxAttempts, errPersistent)
+					}
+				}
 				continue
 			}
 			if errPersistent == nil {
@@ -297,6 +416,15 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 
 		statusError := e.mapStatusError(e, resp)
 
+		observer.OnResponseStatus(ResponseStatusEvent{
+			ExecutionID:   executionID,
+			Name:          name,
+			RequestID:     e.getLogID(e),
+			Attempt:       attempt + 1,
+			NodeAccountID: node.accountID,
+			Status:        _StatusFromError(statusError),
+		})
+
 		var network = "unknown"
 		if client.GetLedgerID() != nil {
 			network = client.GetLedgerID().String()
@@ -312,10 +440,50 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 			"txID", txID,
 		)
 
-		switch e.shouldRetry(e, resp) {
+		executionState := e.shouldRetry(e, resp)
+		backoff := currentBackoff
+		if retryPolicy != nil && (executionState == executionStateRetry || executionState == executionStateError) {
+			decision := retryPolicy.Decide(RetryContext{
+				Attempt:       attempt + 1,
+				MaxAttempts:   maxAttempts,
+				Elapsed:       time.Since(start),
+				NodeAccountID: node.accountID,
+				GrpcCode:      codes.OK,
+				Status:        _StatusFromError(statusError),
+				Err:           statusError,
+				Default:       RetryDecision{Retry: executionState == executionStateRetry, Backoff: currentBackoff},
+			})
+			if decision.SwitchNode {
+				client.network._IncreaseBackoff(node)
+				observer.OnNodeUnhealthy(_NodeUnhealthyEvent(executionID, name, e.getLogID(e), node, statusError))
+			}
+
+			executionState = executionStateError
+			if decision.Retry {
+				executionState = executionStateRetry
+				backoff = decision.Backoff
+			}
+		}
+
+		if receiptQuery, ok := e.(*TransactionReceiptQuery); ok {
+			observer.OnReceiptPoll(ReceiptPollEvent{
+				ExecutionID:   executionID,
+				RequestID:     e.getLogID(e),
+				TransactionID: receiptQuery.GetTransactionID(),
+				Attempt:       attempt + 1,
+				NodeAccountID: node.accountID,
+				Status:        Status(resp.(*services.Response).GetTransactionGetReceipt().GetReceipt().GetStatus()),
+				Done:          executionState != executionStateRetry,
+			})
+		}
+
+		switch executionState {
 		case executionStateRetry:
 			errPersistent = statusError
-			_DelayForAttempt(e.getLogID(e), currentBackoff, attempt, txLogger, errPersistent)
+			observer.OnBackoff(BackoffEvent{ExecutionID: executionID, Name: name, RequestID: e.getLogID(e), Attempt: attempt + 1, NodeAccountID: node.accountID, Backoff: backoff, Err: errPersistent})
+			if err := _DelayForAttempt(ctx, e.getLogID(e), backoff, attempt, txLogger, errPersistent); err != nil {
+				return _ExecutableInterrupted(e, ctx, attempt, maxAttempts, errPersistent)
+			}
 			continue
 		case executionStateExpired:
 			if e.isTransaction() {
@@ -354,10 +522,54 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 	return &services.Response{}, errPersistent
 }
 
-func _DelayForAttempt(logID string, backoff time.Duration, attempt int64, logger Logger, err error) {
+// _DelayForAttempt waits for the backoff duration before the next attempt, returning early with the
+// context's error if ctx is done first.
+func _DelayForAttempt(ctx context.Context, logID string, backoff time.Duration, attempt int64, logger Logger, err error) error {
 	logger.Trace("retrying request attempt", "requestId", logID, "delay", backoff, "attempt", attempt+1, "error", err)
 
-	time.Sleep(backoff)
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// _ExecutableInterrupted builds the zero response and ErrExecutionInterrupted returned when ctx ends execution early.
+func _ExecutableInterrupted(e Executable, ctx context.Context, attempt int64, maxAttempts int, lastErr error) (interface{}, error) { // nolint
+	err := ErrExecutionInterrupted{
+		Attempt:     attempt + 1,
+		MaxAttempts: maxAttempts,
+		Cause:       ctx.Err(),
+		LastError:   lastErr,
+	}
+
+	if e.isTransaction() {
+		return TransactionResponse{}, err
+	}
+
+	return &services.Response{}, err
+}
+
+// _StatusFromError returns the status carried by a status error, StatusOk for any other error.
+func _StatusFromError(err error) Status {
+	var precheckErr ErrHederaPreCheckStatus
+	var receiptErr ErrHederaReceiptStatus
+	var recordErr ErrHederaRecordStatus
+
+	switch {
+	case errors.As(err, &precheckErr):
+		return precheckErr.Status
+	case errors.As(err, &receiptErr):
+		return receiptErr.Status
+	case errors.As(err, &recordErr):
+		return recordErr.Status
+	}
+
+	return StatusOk
 }
 
 func _ExecutableDefaultRetryHandler(logID string, err error, logger Logger) bool {
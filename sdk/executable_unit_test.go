@@ -0,0 +1,179 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitExecuteWithContextAlreadyCancelled(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).
+		ExecuteWithContext(ctx, client)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.Canceled))
+
+	var interrupted ErrExecutionInterrupted
+	require.True(t, errors.As(err, &interrupted))
+	require.Equal(t, int64(1), interrupted.Attempt)
+}
+
+func TestUnitExecuteWithContextInterruptsBackoff(t *testing.T) {
+	t.Parallel()
+
+	busy := &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY, ResponseType: services.ResponseType_ANSWER_ONLY},
+			},
+		},
+	}
+	responses := [][]interface{}{{busy, busy, busy}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetMaxBackoff(30*time.Second).
+		SetMinBackoff(10*time.Second).
+		ExecuteWithContext(ctx, client)
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	var interrupted ErrExecutionInterrupted
+	require.True(t, errors.As(err, &interrupted))
+	require.GreaterOrEqual(t, interrupted.Attempt, int64(1))
+}
+
+func TestUnitGetReceiptWithContextStopsPolling(t *testing.T) {
+	t.Parallel()
+
+	unknown := &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+				Receipt: &services.TransactionReceipt{
+					Status: services.ResponseCodeEnum_UNKNOWN,
+				},
+			},
+		},
+	}
+	responses := [][]interface{}{{unknown, unknown, unknown, unknown}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	response := TransactionResponse{
+		TransactionID: TransactionIDGenerate(AccountID{Account: 1800}),
+		NodeID:        AccountID{Account: 3},
+	}
+
+	_, err := response.GetReceiptWithContext(ctx, client)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.Canceled))
+}
+
+// _CancelOnFailureObserver cancels a context once an execution fails
+type _CancelOnFailureObserver struct {
+	NoopExecutionObserver
+	cancel context.CancelFunc
+}
+
+func (observer _CancelOnFailureObserver) OnExecutionEnd(event ExecutionEndEvent) {
+	if event.Err != nil {
+		observer.cancel()
+	}
+}
+
+func TestUnitGetReceiptWithContextKeepsLastResubmitError(t *testing.T) {
+	t.Parallel()
+
+	throttled := &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+				Receipt: &services.TransactionReceipt{
+					Status: services.ResponseCodeEnum_THROTTLED_AT_CONSENSUS,
+				},
+			},
+		},
+	}
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+		throttled,
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_INVALID_SIGNATURE,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	response, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	// The resubmission fails, then the backoff before the next one is interrupted
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client.SetExecutionObserver(_CancelOnFailureObserver{cancel: cancel})
+
+	_, err = response.GetReceiptWithContext(ctx, client)
+	require.True(t, errors.Is(err, context.Canceled))
+
+	var interrupted ErrExecutionInterrupted
+	require.True(t, errors.As(err, &interrupted))
+	var precheck ErrHederaPreCheckStatus
+	require.True(t, errors.As(interrupted.LastError, &precheck))
+	require.Equal(t, StatusInvalidSignature, precheck.Status)
+}
+
+func TestUnitErrExecutionInterruptedError(t *testing.T) {
+	t.Parallel()
+
+	err := ErrExecutionInterrupted{Attempt: 2, MaxAttempts: 10, Cause: context.Canceled}
+	require.Equal(t, "execution interrupted during attempt 2/10: context canceled", err.Error())
+
+	err.LastError = ErrHederaPreCheckStatus{Status: StatusBusy}
+	require.Equal(t, "execution interrupted during attempt 2/10: context canceled (last error: exceptional precheck status BUSY)", err.Error())
+}
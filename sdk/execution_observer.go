@@ -0,0 +1,263 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ExecutionObserver receives structured events about the execution of transactions, queries and
+// TopicMessageQuery subscriptions, for tracing and metrics. It is registered on the Client with
+// SetExecutionObserver. Events are delivered synchronously from the executing goroutine, so
+// implementations must return quickly and be safe for concurrent use.
+//
+// Embed NoopExecutionObserver to implement only the events of interest.
+type ExecutionObserver interface {
+	// OnExecutionStart is called once when a transaction or query starts executing.
+	OnExecutionStart(ExecutionStartEvent)
+	// OnExecutionEnd is called once when a transaction or query is done executing, successfully or not.
+	OnExecutionEnd(ExecutionEndEvent)
+	// OnAttemptStart is called at the start of every attempt.
+	OnAttemptStart(AttemptStartEvent)
+	// OnNodeChosen is called when the node of an attempt is chosen.
+	OnNodeChosen(NodeChosenEvent)
+	// OnGrpcCall is called when the gRPC call of an attempt returns.
+	OnGrpcCall(GrpcCallEvent)
+	// OnResponseStatus is called with the status a node answered with.
+	OnResponseStatus(ResponseStatusEvent)
+	// OnBackoff is called before waiting between attempts.
+	OnBackoff(BackoffEvent)
+	// OnNodeUnhealthy is called when a node is marked unhealthy and excluded until its readmit time.
+	OnNodeUnhealthy(NodeUnhealthyEvent)
+	// OnReceiptPoll is called every time a TransactionReceiptQuery polls a node for a receipt.
+	OnReceiptPoll(ReceiptPollEvent)
+	// OnTopicStream is called on the lifecycle events of TopicMessageQuery subscriptions.
+	OnTopicStream(TopicStreamEvent)
+}
+
+// ExecutionStartEvent is emitted when a transaction or query starts executing.
+type ExecutionStartEvent struct {
+	// ExecutionID identifies the execution across its events, unique within the process.
+	ExecutionID uint64
+	// Name is the name of the transaction or query type, e.g. "TransferTransaction".
+	Name string
+	// RequestID identifies the request in the logs of the SDK.
+	RequestID     string
+	IsTransaction bool
+	MaxAttempts   int
+	// Context is the context the execution runs under, e.g. the one given to ExecuteWithContext, carrying the trace
+	// of the caller.
+	Context context.Context
+}
+
+// ExecutionEndEvent is emitted when a transaction or query is done executing.
+type ExecutionEndEvent struct {
+	ExecutionID uint64
+	Name        string
+	RequestID   string
+	// Duration is the time spent executing, including all attempts and backoffs.
+	Duration time.Duration
+	// Err is the error the execution failed with, nil on success.
+	Err error
+}
+
+// AttemptStartEvent is emitted at the start of every attempt.
+type AttemptStartEvent struct {
+	ExecutionID uint64
+	Name        string
+	RequestID   string
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int64
+}
+
+// NodeChosenEvent is emitted when the node of an attempt is chosen.
+type NodeChosenEvent struct {
+	ExecutionID   uint64
+	Name          string
+	RequestID     string
+	Attempt       int64
+	NodeAccountID AccountID
+	Address       string
+	// Healthy is false when the node is still backing off, in which case the attempt waits instead of calling it.
+	Healthy bool
+}
+
+// GrpcCallEvent is emitted when the gRPC call of an attempt returns.
+type GrpcCallEvent struct {
+	ExecutionID   uint64
+	Name          string
+	RequestID     string
+	Attempt       int64
+	NodeAccountID AccountID
+	// Latency is the time between sending the request and receiving the response or error.
+	Latency time.Duration
+	// Code is the gRPC status code of the call, codes.OK when the node answered.
+	Code codes.Code
+	Err  error
+}
+
+// ResponseStatusEvent is emitted with the status a node answered with.
+type ResponseStatusEvent struct {
+	ExecutionID   uint64
+	Name          string
+	RequestID     string
+	Attempt       int64
+	NodeAccountID AccountID
+	Status        Status
+}
+
+// BackoffEvent is emitted before waiting between attempts.
+type BackoffEvent struct {
+	ExecutionID   uint64
+	Name          string
+	RequestID     string
+	Attempt       int64
+	NodeAccountID AccountID
+	Backoff       time.Duration
+	// Err is the error of the attempt that is retried.
+	Err error
+}
+
+// NodeUnhealthyEvent is emitted when a node is marked unhealthy.
+type NodeUnhealthyEvent struct {
+	ExecutionID   uint64
+	Name          string
+	RequestID     string
+	NodeAccountID AccountID
+	Address       string
+	// ReadmitTime is when the node is used again.
+	ReadmitTime time.Time
+	// Err is the error that made the node unhealthy.
+	Err error
+}
+
+// ReceiptPollEvent is emitted every time a TransactionReceiptQuery polls a node for a receipt.
+type ReceiptPollEvent struct {
+	ExecutionID   uint64
+	RequestID     string
+	TransactionID TransactionID
+	Attempt       int64
+	NodeAccountID AccountID
+	// Status is the status of the receipt, StatusUnknown while the transaction has not reached consensus.
+	Status Status
+	// Done is true when the receipt is final and polling stops.
+	Done bool
+}
+
+// TopicStreamEventType is the kind of a TopicStreamEvent.
+type TopicStreamEventType uint32
+
+const (
+	// TopicStreamSubscribed is emitted when a subscription stream to a mirror node is opened.
+	TopicStreamSubscribed TopicStreamEventType = iota
+	// TopicStreamMessage is emitted for every message delivered to the subscriber.
+	TopicStreamMessage
+	// TopicStreamRetry is emitted when the stream failed and is opened again after Backoff.
+	TopicStreamRetry
+	// TopicStreamError is emitted when the stream failed and is not retried, or ended with the status of its
+	// context error, CANCELLED after Unsubscribe or TopicMessageStream.Close.
+	TopicStreamError
+	// TopicStreamCompleted is emitted when the mirror node ended the stream.
+	TopicStreamCompleted
+)
+
+// String returns the name of the event type.
+func (eventType TopicStreamEventType) String() string {
+	switch eventType {
+	case TopicStreamSubscribed:
+		return "SUBSCRIBED"
+	case TopicStreamMessage:
+		return "MESSAGE"
+	case TopicStreamRetry:
+		return "RETRY"
+	case TopicStreamError:
+		return "ERROR"
+	case TopicStreamCompleted:
+		return "COMPLETED"
+	}
+
+	return "UNKNOWN"
+}
+
+// TopicStreamEvent is emitted on the lifecycle events of a TopicMessageQuery subscription.
+type TopicStreamEvent struct {
+	// SubscriptionID identifies the subscription across its events, unique within the process.
+	SubscriptionID uint64
+	Type           TopicStreamEventType
+	TopicID        TopicID
+	// Address is the address of the mirror node streaming the messages.
+	Address string
+	// Attempt is the number of retries of the subscription so far.
+	Attempt uint64
+	// SequenceNumber and ConsensusTimestamp are set on TopicStreamMessage events.
+	SequenceNumber     uint64
+	ConsensusTimestamp time.Time
+	// Backoff is set on TopicStreamRetry events.
+	Backoff time.Duration
+	// Err is set on TopicStreamRetry and TopicStreamError events.
+	Err error
+	// Context is the context the subscription runs under, e.g. the one given to Stream, carrying the trace of the
+	// caller.
+	Context context.Context
+}
+
+// NoopExecutionObserver is an ExecutionObserver ignoring every event, to be embedded by observers
+// interested in only some of them.
+type NoopExecutionObserver struct{}
+
+// OnExecutionStart implements ExecutionObserver.
+func (NoopExecutionObserver) OnExecutionStart(ExecutionStartEvent) {}
+
+// OnExecutionEnd implements ExecutionObserver.
+func (NoopExecutionObserver) OnExecutionEnd(ExecutionEndEvent) {}
+
+// OnAttemptStart implements ExecutionObserver.
+func (NoopExecutionObserver) OnAttemptStart(AttemptStartEvent) {}
+
+// OnNodeChosen implements ExecutionObserver.
+func (NoopExecutionObserver) OnNodeChosen(NodeChosenEvent) {}
+
+// OnGrpcCall implements ExecutionObserver.
+func (NoopExecutionObserver) OnGrpcCall(GrpcCallEvent) {}
+
+// OnResponseStatus implements ExecutionObserver.
+func (NoopExecutionObserver) OnResponseStatus(ResponseStatusEvent) {}
+
+// OnBackoff implements ExecutionObserver.
+func (NoopExecutionObserver) OnBackoff(BackoffEvent) {}
+
+// OnNodeUnhealthy implements ExecutionObserver.
+func (NoopExecutionObserver) OnNodeUnhealthy(NodeUnhealthyEvent) {}
+
+// OnReceiptPoll implements ExecutionObserver.
+func (NoopExecutionObserver) OnReceiptPoll(ReceiptPollEvent) {}
+
+// OnTopicStream implements ExecutionObserver.
+func (NoopExecutionObserver) OnTopicStream(TopicStreamEvent) {}
+
+func _NodeUnhealthyEvent(executionID uint64, name string, requestID string, node *_Node, err error) NodeUnhealthyEvent {
+	event := NodeUnhealthyEvent{
+		ExecutionID:   executionID,
+		Name:          name,
+		RequestID:     requestID,
+		NodeAccountID: node.accountID,
+		Address:       node._GetAddress(),
+		Err:           err,
+	}
+
+	if readmitTime := node._GetReadmitTime(); readmitTime != nil {
+		event.ReadmitTime = *readmitTime
+	}
+
+	return event
+}
+
+var _ExecutionIDCounter uint64
+
+func _NextExecutionID() uint64 {
+	return atomic.AddUint64(&_ExecutionIDCounter, 1)
+}
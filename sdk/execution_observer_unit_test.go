@@ -0,0 +1,276 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type _RecordingExecutionObserver struct {
+	mu     sync.Mutex
+	events []interface{}
+}
+
+func (observer *_RecordingExecutionObserver) record(event interface{}) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	observer.events = append(observer.events, event)
+}
+
+func (observer *_RecordingExecutionObserver) OnExecutionStart(event ExecutionStartEvent) {
+	observer.record(event)
+}
+func (observer *_RecordingExecutionObserver) OnExecutionEnd(event ExecutionEndEvent) {
+	observer.record(event)
+}
+func (observer *_RecordingExecutionObserver) OnAttemptStart(event AttemptStartEvent) {
+	observer.record(event)
+}
+func (observer *_RecordingExecutionObserver) OnNodeChosen(event NodeChosenEvent) {
+	observer.record(event)
+}
+func (observer *_RecordingExecutionObserver) OnGrpcCall(event GrpcCallEvent) { observer.record(event) }
+func (observer *_RecordingExecutionObserver) OnResponseStatus(event ResponseStatusEvent) {
+	observer.record(event)
+}
+func (observer *_RecordingExecutionObserver) OnBackoff(event BackoffEvent) { observer.record(event) }
+func (observer *_RecordingExecutionObserver) OnNodeUnhealthy(event NodeUnhealthyEvent) {
+	observer.record(event)
+}
+func (observer *_RecordingExecutionObserver) OnReceiptPoll(event ReceiptPollEvent) {
+	observer.record(event)
+}
+func (observer *_RecordingExecutionObserver) OnTopicStream(event TopicStreamEvent) {
+	observer.record(event)
+}
+
+func (observer *_RecordingExecutionObserver) snapshot() []interface{} {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	return append([]interface{}{}, observer.events...)
+}
+
+func TestUnitExecutionObserverQueryRetry(t *testing.T) {
+	t.Parallel()
+
+	balance := func(code services.ResponseCodeEnum) *services.Response {
+		return &services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: code},
+					Balance: 5,
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{balance(services.ResponseCodeEnum_BUSY), balance(services.ResponseCodeEnum_OK)}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	observer := &_RecordingExecutionObserver{}
+	client.SetExecutionObserver(observer)
+	require.Equal(t, observer, client.GetExecutionObserver())
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 5}).
+		Execute(client)
+	require.NoError(t, err)
+
+	events := observer.snapshot()
+	require.Len(t, events, 11)
+
+	start := events[0].(ExecutionStartEvent)
+	require.Equal(t, "AccountBalanceQuery", start.Name)
+	require.False(t, start.IsTransaction)
+
+	require.Equal(t, int64(1), events[1].(AttemptStartEvent).Attempt)
+	chosen := events[2].(NodeChosenEvent)
+	require.Equal(t, AccountID{Account: 3}, chosen.NodeAccountID)
+	require.True(t, chosen.Healthy)
+	require.Equal(t, codes.OK, events[3].(GrpcCallEvent).Code)
+	require.Equal(t, StatusBusy, events[4].(ResponseStatusEvent).Status)
+	require.Equal(t, StatusBusy, events[5].(BackoffEvent).Err.(ErrHederaPreCheckStatus).Status)
+
+	require.Equal(t, int64(2), events[6].(AttemptStartEvent).Attempt)
+	require.IsType(t, NodeChosenEvent{}, events[7])
+	require.IsType(t, GrpcCallEvent{}, events[8])
+	require.Equal(t, StatusOk, events[9].(ResponseStatusEvent).Status)
+
+	end := events[10].(ExecutionEndEvent)
+	require.NotZero(t, start.ExecutionID)
+	require.Equal(t, start.ExecutionID, end.ExecutionID)
+	require.Equal(t, start.RequestID, end.RequestID)
+	require.NoError(t, end.Err)
+}
+
+func TestUnitExecutionObserverGrpcErrorMarksNodeUnhealthy(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		status.New(codes.Unavailable, "node is down").Err(),
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	observer := &_RecordingExecutionObserver{}
+	client.SetExecutionObserver(observer)
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	var grpcCalls []GrpcCallEvent
+	var unhealthy []NodeUnhealthyEvent
+	for _, event := range observer.snapshot() {
+		switch event := event.(type) {
+		case GrpcCallEvent:
+			grpcCalls = append(grpcCalls, event)
+		case NodeUnhealthyEvent:
+			unhealthy = append(unhealthy, event)
+		}
+	}
+
+	require.Len(t, grpcCalls, 2)
+	require.Equal(t, codes.Unavailable, grpcCalls[0].Code)
+	require.Error(t, grpcCalls[0].Err)
+	require.Equal(t, codes.OK, grpcCalls[1].Code)
+
+	require.Len(t, unhealthy, 1)
+	require.Equal(t, "TransferTransaction", unhealthy[0].Name)
+	require.Equal(t, AccountID{Account: 3}, unhealthy[0].NodeAccountID)
+	require.Equal(t, codes.Unavailable, status.Code(unhealthy[0].Err))
+}
+
+func TestUnitExecutionObserverReceiptPolling(t *testing.T) {
+	t.Parallel()
+
+	receipt := func(code services.ResponseCodeEnum) *services.Response {
+		return &services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					Receipt: &services.TransactionReceipt{Status: code},
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{receipt(services.ResponseCodeEnum_UNKNOWN), receipt(services.ResponseCodeEnum_SUCCESS)}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	observer := &_RecordingExecutionObserver{}
+	client.SetExecutionObserver(observer)
+
+	transactionID := TransactionIDGenerate(AccountID{Account: 1800})
+	_, err := NewTransactionReceiptQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(transactionID).
+		Execute(client)
+	require.NoError(t, err)
+
+	var polls []ReceiptPollEvent
+	for _, event := range observer.snapshot() {
+		if poll, ok := event.(ReceiptPollEvent); ok {
+			polls = append(polls, poll)
+		}
+	}
+
+	require.Len(t, polls, 2)
+	require.Equal(t, transactionID.String(), polls[0].TransactionID.String())
+	require.Equal(t, StatusUnknown, polls[0].Status)
+	require.False(t, polls[0].Done)
+	require.Equal(t, int64(2), polls[1].Attempt)
+	require.Equal(t, StatusSuccess, polls[1].Status)
+	require.True(t, polls[1].Done)
+}
+
+type _ObserverTopicServer struct {
+	mirror.UnimplementedConsensusServiceServer
+}
+
+func (server *_ObserverTopicServer) SubscribeTopic(_ *mirror.ConsensusTopicQuery, stream mirror.ConsensusService_SubscribeTopicServer) error {
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		err := stream.Send(&mirror.ConsensusTopicResponse{
+			ConsensusTimestamp: &services.Timestamp{Seconds: int64(100 + sequence)},
+			Message:            []byte("hello"),
+			SequenceNumber:     sequence,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestUnitExecutionObserverTopicStream(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	mirror.RegisterConsensusServiceServer(grpcServer, &_ObserverTopicServer{})
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	defer grpcServer.Stop()
+
+	client := ClientForNetwork(map[string]AccountID{})
+	defer client.Close()
+	client.SetMirrorNetwork([]string{listener.Addr().String()})
+
+	observer := &_RecordingExecutionObserver{}
+	client.SetExecutionObserver(observer)
+
+	completed := make(chan struct{})
+	_, err = NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetCompletionHandler(func() { close(completed) }).
+		Subscribe(client, func(TopicMessage) {})
+	require.NoError(t, err)
+
+	select {
+	case <-completed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	events := observer.snapshot()
+	require.Len(t, events, 4)
+
+	subscribed := events[0].(TopicStreamEvent)
+	require.Equal(t, TopicStreamSubscribed, subscribed.Type)
+	require.Equal(t, TopicID{Topic: 7}, subscribed.TopicID)
+	require.Equal(t, listener.Addr().String(), subscribed.Address)
+
+	message := events[2].(TopicStreamEvent)
+	require.Equal(t, TopicStreamMessage, message.Type)
+	require.Equal(t, uint64(2), message.SequenceNumber)
+	require.Equal(t, time.Unix(102, 0), message.ConsensusTimestamp)
+
+	require.Equal(t, TopicStreamCompleted, events[3].(TopicStreamEvent).Type)
+	require.Equal(t, "COMPLETED", TopicStreamCompleted.String())
+}
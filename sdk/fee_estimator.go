@@ -0,0 +1,380 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	// fee schedule prices are expressed in thousandths of a tinycent
+	_FeeScheduleDivisorFactor = 1000
+	// size of one signature pair in the signature map, sized for ECDSA(secp256k1) which is the largest
+	_FeeEstimatorSignaturePairSize = 103
+	// size of a transaction receipt, and how long the network keeps it (in seconds)
+	_FeeEstimatorReceiptSize           = 132
+	_FeeEstimatorReceiptStorageSeconds = 180
+	_FeeEstimatorSecondsPerHour        = 3600
+	_FeeEstimatorNodeResponseBytes     = 4
+	_FeeEstimatorDefaultSignatureCount = 1
+)
+
+var errFeeEstimatorNoExchangeRate = errors.New("exchange rate must have non zero hbar and cent equivalents")
+
+// FeeEstimate is the estimated fee of a transaction, split into the node, network and
+// service components of the fee schedule. Every component covers all the chunks of the transaction.
+type FeeEstimate struct {
+	RequestType RequestType
+	// Chunks is the number of transactions the network will process, more than one for chunked
+	// FileAppendTransaction and TopicMessageSubmitTransaction.
+	Chunks int
+	// TransactionSize is the estimated size in bytes of the largest chunk, including signatures.
+	TransactionSize int
+	SignatureCount  int
+	NodeFee         Hbar
+	NetworkFee      Hbar
+	ServiceFee      Hbar
+	Total           Hbar
+}
+
+// String returns a string representation of the FeeEstimate
+func (estimate FeeEstimate) String() string {
+	return fmt.Sprintf("%s: node %s, network %s, service %s, total %s (%d chunk(s) of up to %d bytes, %d signature(s))",
+		estimate.RequestType.String(), estimate.NodeFee.String(), estimate.NetworkFee.String(), estimate.ServiceFee.String(),
+		estimate.Total.String(), estimate.Chunks, estimate.TransactionSize, estimate.SignatureCount)
+}
+
+// FeeEstimator estimates the fee of a frozen transaction offline, from a fee schedule and an
+// exchange rate, so the max transaction fee can be set before the transaction is sent.
+//
+// The estimate follows the usage based fee calculation of the network: each of the node, network
+// and service components prices the transaction size, signature verifications, receipt storage and
+// contract gas. Type specific usage such as file contents storage or custom fees is not modeled, and
+// neither is network congestion pricing.
+type FeeEstimator struct {
+	feeSchedule    FeeSchedule
+	exchangeRate   ExchangeRate
+	signatureCount int
+}
+
+// NewFeeEstimator creates a FeeEstimator using the given fee schedule and exchange rate.
+// The fee schedule is FeeSchedules.GetCurrent() of the fee schedules stored in file 0.0.111,
+// and the exchange rate is returned with every transaction receipt.
+func NewFeeEstimator(feeSchedule FeeSchedule, exchangeRate ExchangeRate) *FeeEstimator {
+	return &FeeEstimator{
+		feeSchedule:    feeSchedule,
+		exchangeRate:   exchangeRate,
+		signatureCount: _FeeEstimatorDefaultSignatureCount,
+	}
+}
+
+// SetFeeSchedule sets the fee schedule used to price transactions.
+func (estimator *FeeEstimator) SetFeeSchedule(feeSchedule FeeSchedule) *FeeEstimator {
+	estimator.feeSchedule = feeSchedule
+	return estimator
+}
+
+// GetFeeSchedule returns the fee schedule used to price transactions.
+func (estimator *FeeEstimator) GetFeeSchedule() FeeSchedule {
+	return estimator.feeSchedule
+}
+
+// SetExchangeRate sets the exchange rate used to convert fees from tinycents to tinybars.
+func (estimator *FeeEstimator) SetExchangeRate(exchangeRate ExchangeRate) *FeeEstimator {
+	estimator.exchangeRate = exchangeRate
+	return estimator
+}
+
+// GetExchangeRate returns the exchange rate used to convert fees from tinycents to tinybars.
+func (estimator *FeeEstimator) GetExchangeRate() ExchangeRate {
+	return estimator.exchangeRate
+}
+
+// SetSignatureCount sets the number of signatures the transaction will carry when it is sent.
+// Signatures already on the transaction are counted even if they exceed this number. Defaults to 1,
+// the operator signature added on execution.
+func (estimator *FeeEstimator) SetSignatureCount(signatureCount int) *FeeEstimator {
+	estimator.signatureCount = signatureCount
+	return estimator
+}
+
+// GetSignatureCount returns the number of signatures the transaction will carry when it is sent.
+func (estimator *FeeEstimator) GetSignatureCount() int {
+	return estimator.signatureCount
+}
+
+// Estimate returns the estimated fee of a frozen transaction.
+func (estimator *FeeEstimator) Estimate(transaction TransactionInterface) (FeeEstimate, error) {
+	if transaction == nil {
+		return FeeEstimate{}, errParameterNull
+	}
+
+	if estimator.exchangeRate.Hbars == 0 || estimator.exchangeRate.cents == 0 {
+		return FeeEstimate{}, errFeeEstimatorNoExchangeRate
+	}
+
+	tx := transaction.getBaseTransaction()
+	if !tx.IsFrozen() {
+		return FeeEstimate{}, errTransactionIsNotFrozen
+	}
+
+	nodes := tx.nodeAccountIDs._Length()
+	if nodes == 0 {
+		nodes = 1
+	}
+	chunks := tx.signedTransactions._Length() / nodes
+
+	estimate := FeeEstimate{Chunks: chunks}
+	var nodeFee, networkFee, serviceFee int64
+
+	for chunk := 0; chunk < chunks; chunk++ {
+		signedTx := tx.signedTransactions._Get(chunk * nodes).(*services.SignedTransaction)
+
+		body := services.TransactionBody{}
+		if err := protobuf.Unmarshal(signedTx.BodyBytes, &body); err != nil {
+			return FeeEstimate{}, err
+		}
+
+		requestType, err := _RequestTypeFromTransactionBody(&body)
+		if err != nil {
+			return FeeEstimate{}, err
+		}
+
+		feeData, err := estimator._FeeData(requestType)
+		if err != nil {
+			return FeeEstimate{}, err
+		}
+
+		signatures := len(signedTx.GetSigMap().GetSigPair())
+		missingSignatures := 0
+		if signatures < estimator.signatureCount {
+			missingSignatures = estimator.signatureCount - signatures
+			signatures = estimator.signatureCount
+		}
+
+		signedTxBytes, err := protobuf.Marshal(signedTx)
+		if err != nil {
+			return FeeEstimate{}, err
+		}
+		size := protobuf.Size(&services.Transaction{SignedTransactionBytes: signedTxBytes}) +
+			missingSignatures*_FeeEstimatorSignaturePairSize
+
+		receiptByteHours := int64((_FeeEstimatorReceiptSize + len(body.Memo)) * _FeeEstimatorReceiptStorageSeconds / _FeeEstimatorSecondsPerHour)
+
+		nodeUsage := FeeComponents{
+			Constant:                 1,
+			TransactionBandwidthByte: int64(size),
+			TransactionVerification:  1,
+			ResponseMemoryByte:       _FeeEstimatorNodeResponseBytes,
+		}
+		networkUsage := FeeComponents{
+			Constant:                 1,
+			TransactionBandwidthByte: int64(size),
+			TransactionVerification:  int64(signatures),
+			TransactionRamByteHour:   receiptByteHours,
+		}
+		serviceUsage := FeeComponents{
+			Constant:               1,
+			TransactionRamByteHour: receiptByteHours,
+			ContractTransactionGas: _GasFromTransactionBody(&body),
+		}
+
+		nodeFee += estimator._TinycentsToTinybars(_ComponentFeeInTinycents(feeData.NodeData, nodeUsage))
+		networkFee += estimator._TinycentsToTinybars(_ComponentFeeInTinycents(feeData.NetworkData, networkUsage))
+		serviceFee += estimator._TinycentsToTinybars(_ComponentFeeInTinycents(feeData.ServiceData, serviceUsage))
+
+		estimate.RequestType = requestType
+		estimate.SignatureCount = signatures
+		if size > estimate.TransactionSize {
+			estimate.TransactionSize = size
+		}
+	}
+
+	estimate.NodeFee = HbarFromTinybar(nodeFee)
+	estimate.NetworkFee = HbarFromTinybar(networkFee)
+	estimate.ServiceFee = HbarFromTinybar(serviceFee)
+	estimate.Total = HbarFromTinybar(nodeFee + networkFee + serviceFee)
+
+	return estimate, nil
+}
+
+func (estimator *FeeEstimator) _FeeData(requestType RequestType) (FeeData, error) {
+	for _, txFeeSchedule := range estimator.feeSchedule.TransactionFeeSchedules {
+		if txFeeSchedule.RequestType != requestType {
+			continue
+		}
+
+		// the first entry holds the prices of the DEFAULT sub type
+		if len(txFeeSchedule.Fees) > 0 && txFeeSchedule.Fees[0] != nil {
+			return *txFeeSchedule.Fees[0], nil
+		}
+
+		if txFeeSchedule.FeeData != nil { // nolint
+			return *txFeeSchedule.FeeData, nil // nolint
+		}
+	}
+
+	return FeeData{}, fmt.Errorf("fee schedule has no prices for %s", requestType.String())
+}
+
+func (estimator *FeeEstimator) _TinycentsToTinybars(tinycents int64) int64 {
+	return tinycents * int64(estimator.exchangeRate.Hbars) / int64(estimator.exchangeRate.cents)
+}
+
+// _ComponentFeeInTinycents prices the usage with the given fee components, clamped to the
+// component's min and max, and never rounds a non zero fee down to zero.
+func _ComponentFeeInTinycents(prices *FeeComponents, usage FeeComponents) int64 {
+	if prices == nil {
+		return 0
+	}
+
+	fee := prices.Constant*usage.Constant +
+		prices.TransactionBandwidthByte*usage.TransactionBandwidthByte +
+		prices.TransactionVerification*usage.TransactionVerification +
+		prices.TransactionRamByteHour*usage.TransactionRamByteHour +
+		prices.TransactionStorageByteHour*usage.TransactionStorageByteHour +
+		prices.ContractTransactionGas*usage.ContractTransactionGas +
+		prices.TransferVolumeHbar*usage.TransferVolumeHbar +
+		prices.ResponseMemoryByte*usage.ResponseMemoryByte +
+		prices.ResponseDiscByte*usage.ResponseDiscByte
+
+	if fee < prices.Min {
+		fee = prices.Min
+	}
+	if prices.Max > 0 && fee > prices.Max {
+		fee = prices.Max
+	}
+
+	if fee > 0 && fee < _FeeScheduleDivisorFactor {
+		return 1
+	}
+
+	return fee / _FeeScheduleDivisorFactor
+}
+
+func _GasFromTransactionBody(body *services.TransactionBody) int64 {
+	switch data := body.Data.(type) {
+	case *services.TransactionBody_ContractCall:
+		return data.ContractCall.GetGas()
+	case *services.TransactionBody_ContractCreateInstance:
+		return data.ContractCreateInstance.GetGas()
+	}
+
+	return 0
+}
+
+// nolint
+func _RequestTypeFromTransactionBody(body *services.TransactionBody) (RequestType, error) {
+	switch body.Data.(type) {
+	case *services.TransactionBody_AtomicBatch:
+		return RequestTypeAtomicBatch, nil
+	case *services.TransactionBody_ConsensusCreateTopic:
+		return RequestTypeConsensusCreateTopic, nil
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return RequestTypeConsensusDeleteTopic, nil
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return RequestTypeConsensusSubmitMessage, nil
+	case *services.TransactionBody_ConsensusUpdateTopic:
+		return RequestTypeConsensusUpdateTopic, nil
+	case *services.TransactionBody_ContractCall:
+		return RequestTypeContractCall, nil
+	case *services.TransactionBody_ContractCreateInstance:
+		return RequestTypeContractCreate, nil
+	case *services.TransactionBody_ContractDeleteInstance:
+		return RequestTypeContractDelete, nil
+	case *services.TransactionBody_ContractUpdateInstance:
+		return RequestTypeContractUpdate, nil
+	case *services.TransactionBody_CryptoAddLiveHash:
+		return RequestTypeCryptoAddLiveHash, nil
+	case *services.TransactionBody_CryptoApproveAllowance:
+		return RequestTypeCryptoApproveAllowance, nil
+	case *services.TransactionBody_CryptoCreateAccount:
+		return RequestTypeCryptoCreate, nil
+	case *services.TransactionBody_CryptoDelete:
+		return RequestTypeCryptoDelete, nil
+	case *services.TransactionBody_CryptoDeleteAllowance:
+		return RequestTypeCryptoDeleteAllowance, nil
+	case *services.TransactionBody_CryptoDeleteLiveHash:
+		return RequestTypeCryptoDeleteLiveHash, nil
+	case *services.TransactionBody_CryptoTransfer:
+		return RequestTypeCryptoTransfer, nil
+	case *services.TransactionBody_CryptoUpdateAccount:
+		return RequestTypeCryptoUpdate, nil
+	case *services.TransactionBody_EthereumTransaction:
+		return RequestTypeEthereumTransaction, nil
+	case *services.TransactionBody_FileAppend:
+		return RequestTypeFileAppend, nil
+	case *services.TransactionBody_FileCreate:
+		return RequestTypeFileCreate, nil
+	case *services.TransactionBody_FileDelete:
+		return RequestTypeFileDelete, nil
+	case *services.TransactionBody_FileUpdate:
+		return RequestTypeFileUpdate, nil
+	case *services.TransactionBody_Freeze:
+		return RequestTypeFreeze, nil
+	case *services.TransactionBody_NodeCreate:
+		return RequestTypeNodeCreate, nil
+	case *services.TransactionBody_NodeDelete:
+		return RequestTypeNodeDelete, nil
+	case *services.TransactionBody_NodeUpdate:
+		return RequestTypeNodeUpdate, nil
+	case *services.TransactionBody_ScheduleCreate:
+		return RequestTypeScheduleCreate, nil
+	case *services.TransactionBody_ScheduleDelete:
+		return RequestTypeScheduleDelete, nil
+	case *services.TransactionBody_ScheduleSign:
+		return RequestTypeScheduleSign, nil
+	case *services.TransactionBody_SystemDelete:
+		return RequestTypeSystemDelete, nil
+	case *services.TransactionBody_SystemUndelete:
+		return RequestTypeSystemUndelete, nil
+	case *services.TransactionBody_TokenAirdrop:
+		return RequestTypeTokenAirdrop, nil
+	case *services.TransactionBody_TokenAssociate:
+		return RequestTypeTokenAssociateToAccount, nil
+	case *services.TransactionBody_TokenBurn:
+		return RequestTypeTokenBurn, nil
+	case *services.TransactionBody_TokenCancelAirdrop:
+		return RequestTypeTokenCancelAirdrop, nil
+	case *services.TransactionBody_TokenClaimAirdrop:
+		return RequestTypeTokenClaimAirdrop, nil
+	case *services.TransactionBody_TokenCreation:
+		return RequestTypeTokenCreate, nil
+	case *services.TransactionBody_TokenDeletion:
+		return RequestTypeTokenDelete, nil
+	case *services.TransactionBody_TokenDissociate:
+		return RequestTypeTokenDissociateFromAccount, nil
+	case *services.TransactionBody_TokenFeeScheduleUpdate:
+		return RequestTypeTokenFeeScheduleUpdate, nil
+	case *services.TransactionBody_TokenFreeze:
+		return RequestTypeTokenFreezeAccount, nil
+	case *services.TransactionBody_TokenGrantKyc:
+		return RequestTypeTokenGrantKycToAccount, nil
+	case *services.TransactionBody_TokenMint:
+		return RequestTypeTokenMint, nil
+	case *services.TransactionBody_TokenPause:
+		return RequestTypeTokenPause, nil
+	case *services.TransactionBody_TokenReject:
+		return RequestTypeTokenReject, nil
+	case *services.TransactionBody_TokenRevokeKyc:
+		return RequestTypeTokenRevokeKycFromAccount, nil
+	case *services.TransactionBody_TokenUnfreeze:
+		return RequestTypeTokenUnfreezeAccount, nil
+	case *services.TransactionBody_TokenUnpause:
+		return RequestTypeTokenUnpause, nil
+	case *services.TransactionBody_TokenUpdate:
+		return RequestTypeTokenUpdate, nil
+	case *services.TransactionBody_TokenUpdateNfts:
+		return RequestTypeTokenUpdateNfts, nil
+	case *services.TransactionBody_TokenWipe:
+		return RequestTypeTokenAccountWipe, nil
+	case *services.TransactionBody_UtilPrng:
+		return RequestTypePrng, nil
+	}
+
+	return RequestTypeNone, errors.New("unrecognized transaction type")
+}
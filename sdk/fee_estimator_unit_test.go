@@ -0,0 +1,125 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func _FeeEstimatorTestSchedule(requestType RequestType) FeeSchedule {
+	max := int64(1_000_000_000_000)
+	return FeeSchedule{
+		TransactionFeeSchedules: []TransactionFeeSchedule{{
+			RequestType: requestType,
+			Fees: []*FeeData{{
+				NodeData:    &FeeComponents{Constant: 100_000, TransactionBandwidthByte: 1000, Max: max},
+				NetworkData: &FeeComponents{Constant: 200_000, TransactionVerification: 50_000, Max: max},
+				ServiceData: &FeeComponents{Constant: 300_000, Max: max},
+			}},
+		}},
+	}
+}
+
+func _FeeEstimatorTestTransfer(t *testing.T) *TransferTransaction {
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	return transaction
+}
+
+func TestUnitFeeEstimatorEstimate(t *testing.T) {
+	t.Parallel()
+
+	estimator := NewFeeEstimator(_FeeEstimatorTestSchedule(RequestTypeCryptoTransfer), NewExchangeRate(2, 1))
+	require.Equal(t, 1, estimator.GetSignatureCount())
+
+	estimate, err := estimator.Estimate(_FeeEstimatorTestTransfer(t))
+	require.NoError(t, err)
+	require.Equal(t, RequestTypeCryptoTransfer, estimate.RequestType)
+	require.Equal(t, 1, estimate.Chunks)
+	require.Equal(t, 1, estimate.SignatureCount)
+	require.Greater(t, estimate.TransactionSize, _FeeEstimatorSignaturePairSize)
+
+	// one hbar is worth one cent, so every tinycent costs two tinybars
+	require.Equal(t, HbarFromTinybar(2*(100+int64(estimate.TransactionSize))), estimate.NodeFee)
+	require.Equal(t, HbarFromTinybar(2*(200+50)), estimate.NetworkFee)
+	require.Equal(t, HbarFromTinybar(2*300), estimate.ServiceFee)
+	require.Equal(t, estimate.NodeFee.AsTinybar()+estimate.NetworkFee.AsTinybar()+estimate.ServiceFee.AsTinybar(), estimate.Total.AsTinybar())
+
+	signed, err := estimator.SetSignatureCount(3).Estimate(_FeeEstimatorTestTransfer(t))
+	require.NoError(t, err)
+	require.Equal(t, 3, signed.SignatureCount)
+	// the length prefix of the signed transaction may grow by a byte
+	require.InDelta(t, estimate.TransactionSize+2*_FeeEstimatorSignaturePairSize, signed.TransactionSize, 1)
+	require.Equal(t, HbarFromTinybar(2*(200+150)), signed.NetworkFee)
+}
+
+func TestUnitFeeEstimatorChunkedTransaction(t *testing.T) {
+	t.Parallel()
+
+	transaction, err := NewFileAppendTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetFileID(FileID{File: 5}).
+		SetContents(make([]byte, 5000)).
+		SetMaxChunkSize(1024).
+		FreezeWith(nil)
+	require.NoError(t, err)
+
+	estimate, err := NewFeeEstimator(_FeeEstimatorTestSchedule(RequestTypeFileAppend), NewExchangeRate(1, 1)).
+		Estimate(transaction)
+	require.NoError(t, err)
+	require.Equal(t, RequestTypeFileAppend, estimate.RequestType)
+	require.Equal(t, 5, estimate.Chunks)
+	require.Equal(t, HbarFromTinybar(5*300), estimate.ServiceFee)
+	require.Equal(t, HbarFromTinybar(5*250), estimate.NetworkFee)
+}
+
+func TestUnitFeeEstimatorWithFeeScheduleFile(t *testing.T) {
+	t.Parallel()
+
+	// nolint
+	dat, err := os.ReadFile("./fee_schedule/fee_schedule.pb")
+	require.NoError(t, err)
+	feeSchedules, err := FeeSchedulesFromBytes(dat)
+	require.NoError(t, err)
+
+	transaction, err := NewAccountCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetKeyWithoutAlias(PublicKey{}).
+		Freeze()
+	require.NoError(t, err)
+
+	estimate, err := NewFeeEstimator(feeSchedules.GetCurrent(), NewExchangeRate(30000, 150000)).Estimate(transaction)
+	require.NoError(t, err)
+	require.Equal(t, RequestTypeCryptoCreate, estimate.RequestType)
+	require.Greater(t, estimate.Total.AsTinybar(), int64(0))
+	require.Greater(t, estimate.ServiceFee.AsTinybar(), estimate.NodeFee.AsTinybar())
+}
+
+func TestUnitFeeEstimatorErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFeeEstimator(_FeeEstimatorTestSchedule(RequestTypeCryptoTransfer), ExchangeRate{}).
+		Estimate(_FeeEstimatorTestTransfer(t))
+	require.ErrorIs(t, err, errFeeEstimatorNoExchangeRate)
+
+	estimator := NewFeeEstimator(_FeeEstimatorTestSchedule(RequestTypeCryptoCreate), NewExchangeRate(1, 1))
+	_, err = estimator.Estimate(_FeeEstimatorTestTransfer(t))
+	require.ErrorContains(t, err, "fee schedule has no prices for CRYPTO_TRANSFER")
+
+	_, err = estimator.Estimate(NewTransferTransaction())
+	require.ErrorIs(t, err, errTransactionIsNotFrozen)
+}
@@ -42,6 +42,24 @@ func _FeeSchedulesFromProtobuf(feeSchedules *services.CurrentAndNextFeeSchedule)
 	}, nil
 }
 
+// GetCurrent returns the fee schedule in effect.
+func (feeSchedules FeeSchedules) GetCurrent() FeeSchedule {
+	if feeSchedules.current == nil {
+		return FeeSchedule{}
+	}
+
+	return *feeSchedules.current
+}
+
+// GetNext returns the fee schedule that takes effect when the current one expires.
+func (feeSchedules FeeSchedules) GetNext() FeeSchedule {
+	if feeSchedules.next == nil {
+		return FeeSchedule{}
+	}
+
+	return *feeSchedules.next
+}
+
 func (feeSchedules FeeSchedules) _ToProtobuf() *services.CurrentAndNextFeeSchedule {
 	var current *services.FeeSchedule
 	if feeSchedules.current != nil {
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -114,7 +115,11 @@ func (tx *FileAppendTransaction) FreezeWith(client *Client) (*FileAppendTransact
 			return tx, errNoClientOrTransactionIDOrNodeId
 		}
 
-		tx.SetNodeAccountIDs(client.network._GetNodeAccountIDsForExecute())
+		nodeAccountIDs, err := client.network._GetNodeAccountIDsForExecute()
+		if err != nil {
+			return tx, err
+		}
+		tx.SetNodeAccountIDs(nodeAccountIDs)
 	}
 
 	tx._InitFee(client)
@@ -185,6 +190,14 @@ func (tx *FileAppendTransaction) FreezeWith(client *Client) (*FileAppendTransact
 // Execute executes the Transaction with the provided client
 func (tx *FileAppendTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping early when ctx is done.
+func (tx *FileAppendTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -194,7 +207,7 @@ func (tx *FileAppendTransaction) Execute(
 		return TransactionResponse{}, tx.freezeError
 	}
 
-	list, err := tx.ExecuteAll(client)
+	list, err := tx.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		if len(list) > 0 {
@@ -216,6 +229,14 @@ func (tx *FileAppendTransaction) Execute(
 // ExecuteAll executes the all the Transactions with the provided client
 func (tx *FileAppendTransaction) ExecuteAll(
 	client *Client,
+) ([]TransactionResponse, error) {
+	return tx.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes the all the Transactions with the provided client, stopping early when ctx is done.
+func (tx *FileAppendTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
 ) ([]TransactionResponse, error) {
 	if client == nil || client.operator == nil {
 		return []TransactionResponse{}, errNoClientProvided
@@ -246,7 +267,7 @@ func (tx *FileAppendTransaction) ExecuteAll(
 	list := make([]TransactionResponse, size)
 
 	for i := 0; i < size; i++ {
-		resp, err := _Execute(client, tx)
+		resp, err := _Execute(ctx, client, tx)
 
 		if err != nil {
 			return list, err
@@ -254,7 +275,7 @@ func (tx *FileAppendTransaction) ExecuteAll(
 
 		list[i] = resp.(TransactionResponse)
 
-		_, err = list[i].SetValidateStatus(true).GetReceipt(client)
+		_, err = list[i].SetValidateStatus(true).GetReceiptWithContext(ctx, client)
 		if err != nil {
 			return list, err
 		}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -48,12 +49,17 @@ func (q *FileInfoQuery) GetFileID() FileID {
 }
 
 func (q *FileInfoQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *FileInfoQuery) Execute(client *Client) (FileInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *FileInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (FileInfo, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return FileInfo{}, err
@@ -115,6 +121,12 @@ func (q *FileInfoQuery) SetLogLevel(level LogLevel) *FileInfoQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *FileInfoQuery) SetRetryPolicy(policy RetryPolicy) *FileInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *FileInfoQuery) getMethod(channel *_Channel) _Method {
@@ -0,0 +1,80 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// _GrpcOptions holds the gRPC dial options and client interceptors set on a Client, shared by the nodes of a
+// network and read when a node opens its connection
+type _GrpcOptions struct {
+	mutex              sync.RWMutex
+	dialOptions        []grpc.DialOption
+	unaryInterceptors  []grpc.UnaryClientInterceptor
+	streamInterceptors []grpc.StreamClientInterceptor
+}
+
+func _NewGrpcOptions() *_GrpcOptions {
+	return &_GrpcOptions{}
+}
+
+func (options *_GrpcOptions) _SetDialOptions(dialOptions []grpc.DialOption) {
+	options.mutex.Lock()
+	defer options.mutex.Unlock()
+	options.dialOptions = append([]grpc.DialOption{}, dialOptions...)
+}
+
+func (options *_GrpcOptions) _GetDialOptions() []grpc.DialOption {
+	options.mutex.RLock()
+	defer options.mutex.RUnlock()
+	return append([]grpc.DialOption{}, options.dialOptions...)
+}
+
+func (options *_GrpcOptions) _SetUnaryInterceptors(interceptors []grpc.UnaryClientInterceptor) {
+	options.mutex.Lock()
+	defer options.mutex.Unlock()
+	options.unaryInterceptors = append([]grpc.UnaryClientInterceptor{}, interceptors...)
+}
+
+func (options *_GrpcOptions) _GetUnaryInterceptors() []grpc.UnaryClientInterceptor {
+	options.mutex.RLock()
+	defer options.mutex.RUnlock()
+	return append([]grpc.UnaryClientInterceptor{}, options.unaryInterceptors...)
+}
+
+func (options *_GrpcOptions) _SetStreamInterceptors(interceptors []grpc.StreamClientInterceptor) {
+	options.mutex.Lock()
+	defer options.mutex.Unlock()
+	options.streamInterceptors = append([]grpc.StreamClientInterceptor{}, interceptors...)
+}
+
+func (options *_GrpcOptions) _GetStreamInterceptors() []grpc.StreamClientInterceptor {
+	options.mutex.RLock()
+	defer options.mutex.RUnlock()
+	return append([]grpc.StreamClientInterceptor{}, options.streamInterceptors...)
+}
+
+// _Apply returns the dial options of the SDK followed by the interceptors and dial options set on the Client, so
+// the options set on the Client take precedence. The interceptors set on the Client run after the interceptors
+// of the SDK.
+func (options *_GrpcOptions) _Apply(sdkOptions ...grpc.DialOption) []grpc.DialOption {
+	if options == nil {
+		return sdkOptions
+	}
+
+	options.mutex.RLock()
+	defer options.mutex.RUnlock()
+
+	dialOptions := append([]grpc.DialOption{}, sdkOptions...)
+	if len(options.unaryInterceptors) > 0 {
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(options.unaryInterceptors...))
+	}
+	if len(options.streamInterceptors) > 0 {
+		dialOptions = append(dialOptions, grpc.WithChainStreamInterceptor(options.streamInterceptors...))
+	}
+
+	return append(dialOptions, options.dialOptions...)
+}
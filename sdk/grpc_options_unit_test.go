@@ -0,0 +1,140 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func _CountingDialer(dials *int32) grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+		atomic.AddInt32(dials, 1)
+		return (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	})
+}
+
+func TestUnitGrpcOptionsApply(t *testing.T) {
+	t.Parallel()
+
+	var nilOptions *_GrpcOptions
+	require.Len(t, nilOptions._Apply(grpc.WithAuthority("a")), 1)
+
+	options := _NewGrpcOptions()
+	require.Len(t, options._Apply(grpc.WithAuthority("a")), 1)
+
+	dialOptions := []grpc.DialOption{grpc.WithAuthority("b")}
+	options._SetDialOptions(dialOptions)
+	options._SetUnaryInterceptors([]grpc.UnaryClientInterceptor{
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		},
+	})
+	options._SetStreamInterceptors([]grpc.StreamClientInterceptor{
+		func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(ctx, desc, cc, method, opts...)
+		},
+	})
+
+	applied := options._Apply(grpc.WithAuthority("a"))
+	require.Len(t, applied, 4)
+	// The options of the client come last, so they take precedence
+	require.Equal(t, dialOptions[0], applied[3])
+
+	// The options are copied in and out
+	dialOptions[0] = nil
+	require.NotNil(t, options._GetDialOptions()[0])
+	options._GetDialOptions()[0] = nil
+	require.NotNil(t, options._GetDialOptions()[0])
+}
+
+func TestUnitClientGrpcOptionsConsensusNode(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	var dials int32
+	var traced []string
+	client.SetGrpcDialOptions(_CountingDialer(&dials))
+	client.SetGrpcUnaryInterceptors(
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			traced = append(traced, method)
+			return invoker(metadata.AppendToOutgoingContext(ctx, "x-trace-id", "1234"), method, req, reply, cc, opts...)
+		},
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			// The interceptors run after the one of the SDK adding the user agent, in order
+			require.Equal(t, []string{"1234"}, md.Get("x-trace-id"))
+			require.Equal(t, []string{getUserAgent()}, md.Get("x-user-agent"))
+			return invoker(ctx, method, req, reply, cc, opts...)
+		},
+	)
+	require.Len(t, client.GetGrpcDialOptions(), 1)
+	require.Len(t, client.GetGrpcUnaryInterceptors(), 2)
+	require.Empty(t, client.GetGrpcStreamInterceptors())
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"/proto.CryptoService/cryptoTransfer"}, traced)
+	require.Equal(t, int32(1), atomic.LoadInt32(&dials))
+}
+
+func TestUnitClientGrpcOptionsMirrorNode(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		return stream.Send(_TopicResponse(1, nil))
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	var dials int32
+	var streams []string
+	client.SetGrpcDialOptions(_CountingDialer(&dials))
+	client.SetGrpcStreamInterceptors(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		streams = append(streams, method)
+		return streamer(ctx, desc, cc, method, opts...)
+	})
+
+	completed := make(chan struct{})
+	_, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetLimit(1).
+		SetCompletionHandler(func() { close(completed) }).
+		SetErrorHandler(func(stat status.Status) { t.Errorf("unexpected error %v", stat.Code()) }).
+		Subscribe(client, func(message TopicMessage) {})
+	require.NoError(t, err)
+
+	select {
+	case <-completed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	require.Equal(t, []string{"/com.hedera.mirror.api.proto.ConsensusService/subscribeTopic"}, streams)
+	require.Equal(t, int32(1), atomic.LoadInt32(&dials))
+}
@@ -0,0 +1,538 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // BIP-32 fingerprints are RIPEMD-160 hashes
+)
+
+// HDWalletKeyType is the type of the keys of an HDWallet
+type HDWalletKeyType int
+
+const (
+	// HDWalletEd25519 derives Ed25519 keys with SLIP-10, which has hardened derivation only
+	HDWalletEd25519 HDWalletKeyType = iota
+	// HDWalletECDSAsecp256k1 derives ECDSA secp256k1 keys with BIP-32
+	HDWalletECDSAsecp256k1
+)
+
+// String returns the name of the key type
+func (keyType HDWalletKeyType) String() string {
+	switch keyType {
+	case HDWalletEd25519:
+		return "ED25519"
+	case HDWalletECDSAsecp256k1:
+		return "ECDSA_SECP256K1"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// The versions of BIP-32 mainnet extended keys, serialized as xprv and xpub
+var (
+	hdWalletPrivateVersion = []byte{0x04, 0x88, 0xAD, 0xE4}
+	hdWalletPublicVersion  = []byte{0x04, 0x88, 0xB2, 0x1E}
+)
+
+// HDWallet is a node of a hierarchical deterministic wallet: a key and the chain code deriving its children. The
+// root node is created from a Mnemonic, or from a BIP-32 extended key. A node without its private key, from
+// Neuter or an xpub, is watch-only, and derives the public keys of its non-hardened secp256k1 children.
+type HDWallet struct {
+	keyType HDWalletKeyType
+	// privateKey is the 32 byte private key, nil for watch-only nodes
+	privateKey []byte
+	// publicKey is the secp256k1 public key, nil for Ed25519 nodes
+	publicKey         *secp256k1.PublicKey
+	ed25519PublicKey  *_Ed25519PublicKey
+	chainCode         []byte
+	depth             uint8
+	parentFingerprint []byte
+	childNumber       uint32
+}
+
+// HDWalletKey is a key of an HDWallet enumerated along a path template
+type HDWalletKey struct {
+	// Path is the derivation path of the key, relative to the node it was enumerated from
+	Path  string
+	Index uint32
+	// PrivateKey is nil when the key was enumerated from a watch-only node
+	PrivateKey *PrivateKey
+	PublicKey  PublicKey
+}
+
+// HDWalletAccount is an account found by HDWallet.Discover, with the key of the wallet it has
+type HDWalletAccount struct {
+	Key       HDWalletKey
+	AccountID AccountID
+}
+
+// NewHDWallet creates the root node of the HD wallet of the mnemonic and passphrase
+func NewHDWallet(mnemonic Mnemonic, passPhrase string, keyType HDWalletKeyType) (*HDWallet, error) {
+	return HDWalletFromSeed(mnemonic._ToSeed(passPhrase), keyType)
+}
+
+// HDWalletFromSeed creates the root node of the HD wallet of the BIP-39 seed
+func HDWalletFromSeed(seed []byte, keyType HDWalletKeyType) (*HDWallet, error) {
+	var hmacKey string
+	switch keyType {
+	case HDWalletEd25519:
+		hmacKey = "ed25519 seed"
+	case HDWalletECDSAsecp256k1:
+		hmacKey = "Bitcoin seed"
+	default:
+		return nil, errors.Errorf("unknown HD wallet key type %d", keyType)
+	}
+
+	h := hmac.New(sha512.New, []byte(hmacKey))
+	if _, err := h.Write(seed); err != nil {
+		return nil, err
+	}
+	digest := h.Sum(nil)
+
+	return _NewHDWallet(keyType, digest[:32], digest[32:], 0, make([]byte, 4), 0)
+}
+
+// HDWalletFromExtendedKey creates the secp256k1 node serialized as a BIP-32 xprv, or as an xpub for a watch-only
+// node
+func HDWalletFromExtendedKey(extendedKey string) (*HDWallet, error) {
+	data, err := _Base58CheckDecode(extendedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 78 {
+		return nil, _NewErrBadKeyf("invalid extended key length: %v bytes", len(data))
+	}
+
+	version, depth, fingerprint := data[:4], data[4], data[5:9]
+	childNumber := binary.BigEndian.Uint32(data[9:13])
+	chainCode, key := data[13:45], data[45:]
+
+	switch {
+	case bytes.Equal(version, hdWalletPrivateVersion):
+		if key[0] != 0 {
+			return nil, _NewErrBadKeyf("invalid extended private key")
+		}
+		return _NewHDWallet(HDWalletECDSAsecp256k1, key[1:], chainCode, depth, fingerprint, childNumber)
+	case bytes.Equal(version, hdWalletPublicVersion):
+		publicKey, err := secp256k1.ParsePubKey(key)
+		if err != nil {
+			return nil, _NewErrBadKeyf("invalid extended public key: %v", err)
+		}
+		return &HDWallet{
+			keyType:           HDWalletECDSAsecp256k1,
+			publicKey:         publicKey,
+			chainCode:         append([]byte{}, chainCode...),
+			depth:             depth,
+			parentFingerprint: append([]byte{}, fingerprint...),
+			childNumber:       childNumber,
+		}, nil
+	default:
+		return nil, _NewErrBadKeyf("unsupported extended key version %x", version)
+	}
+}
+
+func _NewHDWallet(keyType HDWalletKeyType, privateKey []byte, chainCode []byte, depth uint8, parentFingerprint []byte, childNumber uint32) (*HDWallet, error) {
+	wallet := &HDWallet{
+		keyType:           keyType,
+		privateKey:        append([]byte{}, privateKey...),
+		chainCode:         append([]byte{}, chainCode...),
+		depth:             depth,
+		parentFingerprint: append([]byte{}, parentFingerprint...),
+		childNumber:       childNumber,
+	}
+
+	if keyType == HDWalletEd25519 {
+		key, err := _Ed25519PrivateKeyFromBytes(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		wallet.ed25519PublicKey = key._PublicKey()
+	} else {
+		var scalar secp256k1.ModNScalar
+		if overflow := scalar.SetByteSlice(privateKey); overflow || scalar.IsZero() {
+			return nil, _NewErrBadKeyf("invalid secp256k1 private key")
+		}
+		wallet.publicKey = secp256k1.NewPrivateKey(&scalar).PubKey()
+	}
+
+	return wallet, nil
+}
+
+// GetKeyType returns the type of the keys of the wallet
+func (wallet *HDWallet) GetKeyType() HDWalletKeyType {
+	return wallet.keyType
+}
+
+// GetDepth returns how many derivations the node is below the root
+func (wallet *HDWallet) GetDepth() uint8 {
+	return wallet.depth
+}
+
+// GetChildNumber returns the index the node was derived with from its parent, 0 for the root
+func (wallet *HDWallet) GetChildNumber() uint32 {
+	return wallet.childNumber
+}
+
+// IsWatchOnly returns true if the node has no private key
+func (wallet *HDWallet) IsWatchOnly() bool {
+	return wallet.privateKey == nil
+}
+
+// Neuter returns the watch-only node of the wallet, without its private key
+func (wallet *HDWallet) Neuter() *HDWallet {
+	neutered := *wallet
+	neutered.privateKey = nil
+	return &neutered
+}
+
+// PrivateKey returns the private key of the node, which derives its children like the node does
+func (wallet *HDWallet) PrivateKey() (PrivateKey, error) {
+	if wallet.IsWatchOnly() {
+		return PrivateKey{}, _NewErrBadKeyf("watch-only HD wallet has no private key")
+	}
+
+	if wallet.keyType == HDWalletEd25519 {
+		key, err := _Ed25519PrivateKeyFromBytes(wallet.privateKey)
+		if err != nil {
+			return PrivateKey{}, err
+		}
+		key.chainCode = append([]byte{}, wallet.chainCode...)
+		return PrivateKey{ed25519PrivateKey: key}, nil
+	}
+
+	key, err := _ECDSAPrivateKeyFromBytes(wallet.privateKey)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	key.chainCode = append([]byte{}, wallet.chainCode...)
+	return PrivateKey{ecdsaPrivateKey: key}, nil
+}
+
+// PublicKey returns the public key of the node
+func (wallet *HDWallet) PublicKey() PublicKey {
+	if wallet.keyType == HDWalletEd25519 {
+		return PublicKey{ed25519PublicKey: wallet.ed25519PublicKey}
+	}
+
+	return PublicKey{ecdsaPublicKey: &_ECDSAPublicKey{wallet.publicKey}}
+}
+
+// ExtendedPrivateKey returns the node serialized as a BIP-32 xprv. Only secp256k1 nodes have extended keys.
+func (wallet *HDWallet) ExtendedPrivateKey() (string, error) {
+	if wallet.keyType != HDWalletECDSAsecp256k1 {
+		return "", _NewErrBadKeyf("extended keys are only defined for secp256k1 HD wallets")
+	}
+	if wallet.IsWatchOnly() {
+		return "", _NewErrBadKeyf("watch-only HD wallet has no private key")
+	}
+
+	return wallet._Serialize(hdWalletPrivateVersion, append([]byte{0}, wallet.privateKey...)), nil
+}
+
+// ExtendedPublicKey returns the node serialized as a BIP-32 xpub. Only secp256k1 nodes have extended keys.
+func (wallet *HDWallet) ExtendedPublicKey() (string, error) {
+	if wallet.keyType != HDWalletECDSAsecp256k1 {
+		return "", _NewErrBadKeyf("extended keys are only defined for secp256k1 HD wallets")
+	}
+
+	return wallet._Serialize(hdWalletPublicVersion, wallet.publicKey.SerializeCompressed()), nil
+}
+
+func (wallet *HDWallet) _Serialize(version []byte, key []byte) string {
+	data := make([]byte, 0, 78)
+	data = append(data, version...)
+	data = append(data, wallet.depth)
+	data = append(data, wallet.parentFingerprint...)
+	data = binary.BigEndian.AppendUint32(data, wallet.childNumber)
+	data = append(data, wallet.chainCode...)
+	data = append(data, key...)
+
+	return _Base58CheckEncode(data)
+}
+
+// _Fingerprint returns the first 4 bytes of the HASH160 of the public key of a secp256k1 node
+func (wallet *HDWallet) _Fingerprint() []byte {
+	sha := sha256.Sum256(wallet.publicKey.SerializeCompressed())
+	h := ripemd160.New()
+	_, _ = h.Write(sha[:])
+	return h.Sum(nil)[:4]
+}
+
+// Derive returns the child of the node with the index, hardened with ToHardenedIndex. Ed25519 nodes have hardened
+// children only, and watch-only nodes non-hardened children only.
+func (wallet *HDWallet) Derive(index uint32) (*HDWallet, error) {
+	if wallet.keyType == HDWalletEd25519 {
+		if !IsHardenedIndex(index) {
+			return nil, _NewErrBadKeyf("ed25519 HD wallets only derive hardened children")
+		}
+		if wallet.IsWatchOnly() {
+			return nil, _NewErrBadKeyf("watch-only HD wallet cannot derive hardened children")
+		}
+
+		// _DeriveEd25519ChildKey hardens the index itself
+		key, chainCode, err := _DeriveEd25519ChildKey(wallet.privateKey, wallet.chainCode, index&^hardenedBit)
+		if err != nil {
+			return nil, err
+		}
+		return _NewHDWallet(wallet.keyType, key, chainCode, wallet.depth+1, make([]byte, 4), index)
+	}
+
+	if wallet.IsWatchOnly() {
+		return wallet._DerivePublic(index)
+	}
+
+	key, chainCode, err := _DeriveECDSAChildKey(wallet.privateKey, wallet.chainCode, index)
+	if err != nil {
+		return nil, err
+	}
+	return _NewHDWallet(wallet.keyType, key, chainCode, wallet.depth+1, wallet._Fingerprint(), index)
+}
+
+// _DerivePublic derives the public key of a non-hardened secp256k1 child, as BIP-32 CKDpub
+func (wallet *HDWallet) _DerivePublic(index uint32) (*HDWallet, error) {
+	if IsHardenedIndex(index) {
+		return nil, _NewErrBadKeyf("watch-only HD wallet cannot derive hardened children")
+	}
+
+	h := hmac.New(sha512.New, wallet.chainCode)
+	_, _ = h.Write(wallet.publicKey.SerializeCompressed())
+	_, _ = h.Write(binary.BigEndian.AppendUint32(nil, index))
+	digest := h.Sum(nil)
+
+	var tweak secp256k1.ModNScalar
+	if overflow := tweak.SetByteSlice(digest[:32]); overflow {
+		return nil, _NewErrBadKeyf("child %d of the HD wallet is invalid", index)
+	}
+
+	var tweakPoint, parentPoint, childPoint secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(&tweak, &tweakPoint)
+	wallet.publicKey.AsJacobian(&parentPoint)
+	secp256k1.AddNonConst(&tweakPoint, &parentPoint, &childPoint)
+	if (childPoint.X.IsZero() && childPoint.Y.IsZero()) || childPoint.Z.IsZero() {
+		return nil, _NewErrBadKeyf("child %d of the HD wallet is invalid", index)
+	}
+	childPoint.ToAffine()
+
+	return &HDWallet{
+		keyType:           wallet.keyType,
+		publicKey:         secp256k1.NewPublicKey(&childPoint.X, &childPoint.Y),
+		chainCode:         digest[32:],
+		depth:             wallet.depth + 1,
+		parentFingerprint: wallet._Fingerprint(),
+		childNumber:       index,
+	}, nil
+}
+
+// DerivePath returns the node at the derivation path, like `m/44'/3030'/0'/0/1`. A path without the leading `m`
+// is relative to the node, like `0/1` from an account xpub. Hardened indices end with `'` or `h`.
+func (wallet *HDWallet) DerivePath(path string) (*HDWallet, error) {
+	indices, absolute, err := _ParseHDWalletPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if absolute && wallet.depth != 0 {
+		return nil, errors.Errorf("derivation path %s starts at the root, but the HD wallet is at depth %d", path, wallet.depth)
+	}
+
+	node := wallet
+	for _, index := range indices {
+		if node, err = node.Derive(index); err != nil {
+			return nil, err
+		}
+	}
+
+	return node, nil
+}
+
+// Enumerate returns count keys along the path template, whose `{index}` is replaced by start, start + 1 and so
+// on, like `m/44'/3030'/0'/0/{index}` or `m/44'/3030'/0'/0'/{index}'`
+func (wallet *HDWallet) Enumerate(template string, start uint32, count uint32) ([]HDWalletKey, error) {
+	if strings.Count(template, "{index}") != 1 {
+		return nil, errors.Errorf("path template %s should have one {index}", template)
+	}
+
+	// The part of the path before the index is derived once
+	prefix := template[:strings.Index(template, "{index}")]
+	suffix := template[strings.Index(template, "{index}")+len("{index}"):]
+	parent := wallet
+	if prefixPath := strings.TrimSuffix(prefix, "/"); prefixPath != "" {
+		var err error
+		if parent, err = wallet.DerivePath(prefixPath); err != nil {
+			return nil, err
+		}
+	}
+
+	keys := make([]HDWalletKey, 0, count)
+	for i := uint32(0); i < count; i++ {
+		index := start + i
+		relativePath := strconv.FormatUint(uint64(index), 10) + suffix
+		child, err := parent.DerivePath(relativePath)
+		if err != nil {
+			return nil, err
+		}
+
+		key := HDWalletKey{Path: prefix + relativePath, Index: index, PublicKey: child.PublicKey()}
+		if !child.IsWatchOnly() {
+			privateKey, err := child.PrivateKey()
+			if err != nil {
+				return nil, err
+			}
+			key.PrivateKey = &privateKey
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// Discover finds the accounts of the keys along the path template with the mirror node, from the index 0 until
+// gapLimit consecutive keys have no account
+func (wallet *HDWallet) Discover(ctx context.Context, client *Client, template string, gapLimit uint32) ([]HDWalletAccount, error) {
+	if gapLimit == 0 {
+		return nil, errors.New("gap limit should be greater than 0")
+	}
+
+	var accounts []HDWalletAccount
+	unused := uint32(0)
+	for index := uint32(0); unused < gapLimit; index++ {
+		keys, err := wallet.Enumerate(template, index, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		params := NewMirrorNodeQueryParams().AddFilter("account.publickey", hex.EncodeToString(keys[0].PublicKey.BytesRaw()))
+		page, err := client.GetMirrorNodeClient().GetAccounts(ctx, params)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to discover the accounts of %s", keys[0].Path)
+		}
+
+		found := false
+		for page != nil {
+			for _, account := range page.Items {
+				accountID, err := AccountIDFromString(account.Account)
+				if err != nil {
+					return nil, err
+				}
+				accounts = append(accounts, HDWalletAccount{Key: keys[0], AccountID: accountID})
+				found = true
+			}
+
+			if !page.HasNext() {
+				break
+			}
+			if page, err = page.Next(ctx); err != nil {
+				return nil, errors.Wrapf(err, "failed to discover the accounts of %s", keys[0].Path)
+			}
+		}
+
+		if found {
+			unused = 0
+		} else {
+			unused++
+		}
+	}
+
+	return accounts, nil
+}
+
+// _ParseHDWalletPath returns the indices of the derivation path, and whether it starts at the root
+func _ParseHDWalletPath(path string) ([]uint32, bool, error) {
+	segments := strings.Split(path, "/")
+	absolute := segments[0] == "m"
+	if absolute {
+		segments = segments[1:]
+	}
+
+	indices := make([]uint32, 0, len(segments))
+	for _, segment := range segments {
+		hardened := strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h")
+		segment = strings.TrimRight(segment, "'h")
+
+		index, err := strconv.ParseUint(segment, 10, 31)
+		if err != nil {
+			return nil, false, errors.Errorf("invalid derivation path %s", path)
+		}
+		if hardened {
+			indices = append(indices, ToHardenedIndex(uint32(index)))
+		} else {
+			indices = append(indices, uint32(index))
+		}
+	}
+
+	return indices, absolute, nil
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// _Base58CheckEncode encodes the data with a 4 byte double SHA-256 checksum in base58
+func _Base58CheckEncode(data []byte) string {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	data = append(append([]byte{}, data...), second[:4]...)
+
+	number := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	modulo := new(big.Int)
+	var encoded []byte
+	for number.Sign() > 0 {
+		number.DivMod(number, radix, modulo)
+		encoded = append(encoded, base58Alphabet[modulo.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return string(encoded)
+}
+
+// _Base58CheckDecode decodes base58 data and verifies its checksum
+func _Base58CheckDecode(encoded string) ([]byte, error) {
+	number := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range encoded {
+		digit := strings.IndexRune(base58Alphabet, c)
+		if digit < 0 {
+			return nil, _NewErrBadKeyf("invalid base58 character %q", c)
+		}
+		number.Mul(number, radix)
+		number.Add(number, big.NewInt(int64(digit)))
+	}
+
+	zeros := 0
+	for zeros < len(encoded) && encoded[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	data := append(make([]byte, zeros), number.Bytes()...)
+	if len(data) < 4 {
+		return nil, _NewErrBadKeyf("base58 data is too short")
+	}
+
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(checksum, second[:4]) {
+		return nil, _NewErrBadKeyf("invalid base58 checksum")
+	}
+
+	return payload, nil
+}
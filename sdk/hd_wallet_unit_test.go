@@ -0,0 +1,234 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitHDWalletBIP32Vector(t *testing.T) {
+	t.Parallel()
+
+	// Test vector 1 of BIP-32
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+	root, err := HDWalletFromSeed(seed, HDWalletECDSAsecp256k1)
+	require.NoError(t, err)
+
+	for path, xpub := range map[string]string{
+		"m":                      "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8",
+		"m/0'":                   "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+		"m/0h/1":                 "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+		"m/0'/1/2'/2/1000000000": "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy",
+	} {
+		node, err := root.DerivePath(path)
+		require.NoError(t, err)
+
+		extendedPublicKey, err := node.ExtendedPublicKey()
+		require.NoError(t, err)
+		assert.Equal(t, xpub, extendedPublicKey, path)
+	}
+
+	extendedPrivateKey, err := root.ExtendedPrivateKey()
+	require.NoError(t, err)
+	assert.Equal(t, "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi", extendedPrivateKey)
+}
+
+func TestUnitHDWalletExtendedKeyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+	root, err := NewHDWallet(mnemonic, "", HDWalletECDSAsecp256k1)
+	require.NoError(t, err)
+	account, err := root.DerivePath("m/44'/3030'/0'")
+	require.NoError(t, err)
+
+	xprv, err := account.ExtendedPrivateKey()
+	require.NoError(t, err)
+	imported, err := HDWalletFromExtendedKey(xprv)
+	require.NoError(t, err)
+	assert.False(t, imported.IsWatchOnly())
+	assert.Equal(t, uint8(3), imported.GetDepth())
+	assert.Equal(t, ToHardenedIndex(0), imported.GetChildNumber())
+	importedXprv, err := imported.ExtendedPrivateKey()
+	require.NoError(t, err)
+	assert.Equal(t, xprv, importedXprv)
+
+	xpub, err := account.ExtendedPublicKey()
+	require.NoError(t, err)
+	watchOnly, err := HDWalletFromExtendedKey(xpub)
+	require.NoError(t, err)
+	assert.True(t, watchOnly.IsWatchOnly())
+	_, err = watchOnly.ExtendedPrivateKey()
+	require.Error(t, err)
+	_, err = watchOnly.PrivateKey()
+	require.Error(t, err)
+
+	_, err = HDWalletFromExtendedKey(xpub[:len(xpub)-1] + "1")
+	require.ErrorContains(t, err, "checksum")
+	_, err = HDWalletFromExtendedKey("0OIl")
+	require.ErrorContains(t, err, "invalid base58 character")
+}
+
+func TestUnitHDWalletWatchOnlyDerivation(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+	root, err := NewHDWallet(mnemonic, passPhrase, HDWalletECDSAsecp256k1)
+	require.NoError(t, err)
+	account, err := root.DerivePath("m/44'/3030'/0'")
+	require.NoError(t, err)
+
+	watchOnly := account.Neuter()
+	assert.False(t, account.IsWatchOnly())
+	_, err = watchOnly.Derive(ToHardenedIndex(0))
+	require.ErrorContains(t, err, "cannot derive hardened children")
+
+	for index := uint32(0); index < 5; index++ {
+		path := fmt.Sprintf("0/%d", index)
+		private, err := account.DerivePath(path)
+		require.NoError(t, err)
+		public, err := watchOnly.DerivePath(path)
+		require.NoError(t, err)
+		assert.Equal(t, private.PublicKey().StringRaw(), public.PublicKey().StringRaw())
+
+		privateXpub, err := private.ExtendedPublicKey()
+		require.NoError(t, err)
+		publicXpub, err := public.ExtendedPublicKey()
+		require.NoError(t, err)
+		assert.Equal(t, privateXpub, publicXpub)
+
+		// The keys are the standard keys of the mnemonic
+		standard, err := mnemonic.ToStandardECDSAsecp256k1PrivateKey(passPhrase, index)
+		require.NoError(t, err)
+		privateKey, err := private.PrivateKey()
+		require.NoError(t, err)
+		assert.Equal(t, standard.StringRaw(), privateKey.StringRaw())
+	}
+
+	_, err = watchOnly.DerivePath("m/0")
+	require.ErrorContains(t, err, "starts at the root")
+}
+
+func TestUnitHDWalletEd25519(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+	root, err := NewHDWallet(mnemonic, "", HDWalletEd25519)
+	require.NoError(t, err)
+
+	node, err := root.DerivePath("m/44'/3030'/0'/0'/2147483647'")
+	require.NoError(t, err)
+	standard, err := mnemonic.ToStandardEd25519PrivateKey("", 2147483647)
+	require.NoError(t, err)
+	privateKey, err := node.PrivateKey()
+	require.NoError(t, err)
+	assert.Equal(t, standard.StringRaw(), privateKey.StringRaw())
+	assert.Equal(t, standard.PublicKey().StringRaw(), node.PublicKey().StringRaw())
+	assert.Equal(t, standard.PublicKey().StringRaw(), node.Neuter().PublicKey().StringRaw())
+
+	_, err = root.Derive(0)
+	require.ErrorContains(t, err, "only derive hardened children")
+	_, err = root.ExtendedPrivateKey()
+	require.ErrorContains(t, err, "only defined for secp256k1")
+
+	_, err = root.DerivePath("m/44'/x")
+	require.ErrorContains(t, err, "invalid derivation path")
+}
+
+func TestUnitHDWalletEnumerate(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+	root, err := NewHDWallet(mnemonic, "", HDWalletEd25519)
+	require.NoError(t, err)
+
+	keys, err := root.Enumerate("m/44'/3030'/0'/0'/{index}'", 2, 3)
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+	for i, key := range keys {
+		index := uint32(2 + i)
+		assert.Equal(t, fmt.Sprintf("m/44'/3030'/0'/0'/%d'", index), key.Path)
+		assert.Equal(t, index, key.Index)
+
+		standard, err := mnemonic.ToStandardEd25519PrivateKey("", index)
+		require.NoError(t, err)
+		require.NotNil(t, key.PrivateKey)
+		assert.Equal(t, standard.StringRaw(), key.PrivateKey.StringRaw())
+	}
+
+	// Enumerate public keys from an account xpub
+	ecdsaRoot, err := NewHDWallet(mnemonic, "", HDWalletECDSAsecp256k1)
+	require.NoError(t, err)
+	account, err := ecdsaRoot.DerivePath("m/44'/3030'/0'")
+	require.NoError(t, err)
+	keys, err = account.Neuter().Enumerate("0/{index}", 0, 2)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "0/1", keys[1].Path)
+	assert.Nil(t, keys[1].PrivateKey)
+	standard, err := mnemonic.ToStandardECDSAsecp256k1PrivateKey("", 1)
+	require.NoError(t, err)
+	assert.Equal(t, standard.PublicKey().StringRaw(), keys[1].PublicKey.StringRaw())
+
+	_, err = root.Enumerate("m/44'/3030'/0'/0'/0'", 0, 1)
+	require.ErrorContains(t, err, "should have one {index}")
+}
+
+func TestUnitHDWalletDiscover(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+	root, err := NewHDWallet(mnemonic, "", HDWalletEd25519)
+	require.NoError(t, err)
+	template := "m/44'/3030'/0'/0'/{index}'"
+	keys, err := root.Enumerate(template, 0, 6)
+	require.NoError(t, err)
+
+	// The keys 0 and 2 have accounts, the key 2 has two
+	used := map[string]string{
+		hex.EncodeToString(keys[0].PublicKey.BytesRaw()): `{"accounts": [{"account": "0.0.1001"}], "links": {"next": null}}`,
+		hex.EncodeToString(keys[2].PublicKey.BytesRaw()): `{"accounts": [{"account": "0.0.1002"}, {"account": "0.0.1003"}], "links": {"next": null}}`,
+	}
+	var requests int32
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		require.Equal(t, "/api/v1/accounts", r.URL.Path)
+		if response, ok := used[r.URL.Query().Get("account.publickey")]; ok {
+			_, _ = io.WriteString(w, response)
+			return
+		}
+		_, _ = io.WriteString(w, `{"accounts": [], "links": {"next": null}}`)
+	})
+
+	accounts, err := root.Discover(context.Background(), client, template, 2)
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+	assert.Equal(t, AccountID{Account: 1001}, accounts[0].AccountID)
+	assert.Equal(t, uint32(0), accounts[0].Key.Index)
+	assert.Equal(t, AccountID{Account: 1003}, accounts[2].AccountID)
+	assert.Equal(t, uint32(2), accounts[2].Key.Index)
+
+	// The keys 3 and 4 are the gap which ends the discovery
+	assert.Equal(t, int32(5), atomic.LoadInt32(&requests))
+
+	_, err = root.Discover(context.Background(), client, template, 0)
+	require.Error(t, err)
+}
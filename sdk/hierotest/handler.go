@@ -0,0 +1,387 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// _HandleTransaction prechecks the transaction, then hands it to the ledger and answers with the
+// precheck status. The outcome is left in the receipt.
+func (node *Node) _HandleTransaction(transaction *services.Transaction) (*services.TransactionResponse, error) {
+	if status, ok := node._TakeInjectedStatus(); ok {
+		return _TransactionResponse(status), nil
+	}
+
+	signedTransaction := &services.SignedTransaction{}
+	if err := protobuf.Unmarshal(transaction.GetSignedTransactionBytes(), signedTransaction); err != nil {
+		return _TransactionResponse(hiero.StatusInvalidTransaction), nil
+	}
+
+	body := &services.TransactionBody{}
+	if err := protobuf.Unmarshal(signedTransaction.GetBodyBytes(), body); err != nil {
+		return _TransactionResponse(hiero.StatusInvalidTransactionBody), nil
+	}
+
+	if body.GetNodeAccountID().GetAccountNum() != int64(node.accountID.Account) {
+		return _TransactionResponse(hiero.StatusInvalidNodeAccount), nil
+	}
+
+	status := node.ledger._HandleTransaction(body, signedTransaction)
+	return _TransactionResponse(status), nil
+}
+
+// _HandleTransaction runs the ledger prechecks on the transaction, and applies it if they pass.
+func (ledger *Ledger) _HandleTransaction(body *services.TransactionBody, signedTransaction *services.SignedTransaction) hiero.Status {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	key := _TransactionIDKey(body.GetTransactionID())
+	if _, ok := ledger.receipts[key]; ok {
+		return hiero.StatusDuplicateTransaction
+	}
+
+	payer, ok := ledger.accounts[body.GetTransactionID().GetAccountID().GetAccountNum()]
+	if !ok || payer.deleted {
+		return hiero.StatusPayerAccountNotFound
+	}
+
+	if !_IsSignedBy(payer.key, signedTransaction) {
+		return hiero.StatusInvalidSignature
+	}
+
+	var receipt *services.TransactionReceipt
+	if ledger.transactionHook != nil {
+		if status, ok := ledger.transactionHook(body); ok {
+			receipt = &services.TransactionReceipt{Status: services.ResponseCodeEnum(status)}
+		}
+	}
+	if receipt == nil {
+		receipt = ledger._Apply(body)
+	}
+
+	ledger.receipts[key] = &_Receipt{receipt: receipt, polls: ledger.receiptPolls}
+	return hiero.StatusOk
+}
+
+// _Apply applies the transaction to the ledger and returns its receipt. A failed transaction leaves
+// the ledger unchanged.
+func (ledger *Ledger) _Apply(body *services.TransactionBody) *services.TransactionReceipt {
+	receipt := &services.TransactionReceipt{Status: services.ResponseCodeEnum_SUCCESS}
+	var status hiero.Status
+
+	switch data := body.GetData().(type) {
+	case *services.TransactionBody_CryptoCreateAccount:
+		status = ledger._CreateAccount(body, data.CryptoCreateAccount, receipt)
+	case *services.TransactionBody_CryptoTransfer:
+		status = ledger._Transfer(data.CryptoTransfer)
+	case *services.TransactionBody_CryptoDelete:
+		status = ledger._DeleteAccount(data.CryptoDelete)
+	case *services.TransactionBody_TokenCreation:
+		status = ledger._CreateToken(data.TokenCreation, receipt)
+	case *services.TransactionBody_TokenAssociate:
+		status = ledger._AssociateTokens(data.TokenAssociate)
+	case *services.TransactionBody_TokenMint:
+		status = ledger._MintToken(data.TokenMint, receipt)
+	case *services.TransactionBody_ConsensusCreateTopic:
+		status = ledger._CreateTopic(data.ConsensusCreateTopic, receipt)
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		status = ledger._DeleteTopic(data.ConsensusDeleteTopic)
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		status = ledger._SubmitMessage(data.ConsensusSubmitMessage, receipt)
+	default:
+		status = hiero.StatusNotSupported
+	}
+
+	if status != hiero.StatusSuccess {
+		return &services.TransactionReceipt{Status: services.ResponseCodeEnum(status)}
+	}
+
+	return receipt
+}
+
+func (ledger *Ledger) _CreateAccount(body *services.TransactionBody, create *services.CryptoCreateTransactionBody, receipt *services.TransactionReceipt) hiero.Status {
+	payer := ledger.accounts[body.GetTransactionID().GetAccountID().GetAccountNum()]
+	if create.GetKey() == nil {
+		return hiero.StatusKeyRequired
+	}
+	if payer.balance < int64(create.GetInitialBalance()) {
+		return hiero.StatusInsufficientPayerBalance
+	}
+
+	payer.balance -= int64(create.GetInitialBalance())
+	num := ledger._NextEntityNum()
+	ledger.accounts[num] = &_Account{
+		key:           create.GetKey(),
+		balance:       int64(create.GetInitialBalance()),
+		memo:          create.GetMemo(),
+		tokenBalances: map[int64]int64{},
+	}
+	receipt.AccountID = &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: num}}
+	return hiero.StatusSuccess
+}
+
+func (ledger *Ledger) _Transfer(transfer *services.CryptoTransferTransactionBody) hiero.Status {
+	hbarBalances := map[int64]int64{}
+	if status := ledger._CheckTransfers(transfer.GetTransfers().GetAccountAmounts(), hbarBalances, func(account *_Account) (int64, bool) {
+		return account.balance, true
+	}); status != hiero.StatusSuccess {
+		return status
+	}
+
+	tokenBalances := map[int64]map[int64]int64{}
+	for _, tokenTransfers := range transfer.GetTokenTransfers() {
+		tokenNum := tokenTransfers.GetToken().GetTokenNum()
+		if _, ok := ledger.tokens[tokenNum]; !ok {
+			return hiero.StatusInvalidTokenID
+		}
+		if len(tokenTransfers.GetNftTransfers()) > 0 {
+			return hiero.StatusNotSupported
+		}
+
+		balances := map[int64]int64{}
+		if status := ledger._CheckTransfers(tokenTransfers.GetTransfers(), balances, func(account *_Account) (int64, bool) {
+			balance, ok := account.tokenBalances[tokenNum]
+			return balance, ok
+		}); status != hiero.StatusSuccess {
+			if status == hiero.StatusInsufficientAccountBalance {
+				return hiero.StatusInsufficientTokenBalance
+			}
+			return status
+		}
+		tokenBalances[tokenNum] = balances
+	}
+
+	for num, balance := range hbarBalances {
+		ledger.accounts[num].balance = balance
+	}
+	for tokenNum, balances := range tokenBalances {
+		for num, balance := range balances {
+			ledger.accounts[num].tokenBalances[tokenNum] = balance
+		}
+	}
+
+	return hiero.StatusSuccess
+}
+
+// _CheckTransfers computes the balances after the transfers into balances, from the balance returned
+// by current, which is false when the account cannot hold the asset.
+func (ledger *Ledger) _CheckTransfers(amounts []*services.AccountAmount, balances map[int64]int64, current func(*_Account) (int64, bool)) hiero.Status {
+	var sum int64
+	for _, amount := range amounts {
+		num := amount.GetAccountID().GetAccountNum()
+		account, ok := ledger.accounts[num]
+		if !ok {
+			return hiero.StatusInvalidAccountID
+		}
+		if account.deleted {
+			return hiero.StatusAccountDeleted
+		}
+
+		balance, ok := balances[num]
+		if !ok {
+			if balance, ok = current(account); !ok {
+				return hiero.StatusTokenNotAssociatedToAccount
+			}
+		}
+
+		balances[num] = balance + amount.GetAmount()
+		sum += amount.GetAmount()
+	}
+
+	if sum != 0 {
+		return hiero.StatusInvalidAccountAmounts
+	}
+
+	for _, balance := range balances {
+		if balance < 0 {
+			return hiero.StatusInsufficientAccountBalance
+		}
+	}
+
+	return hiero.StatusSuccess
+}
+
+func (ledger *Ledger) _DeleteAccount(deletion *services.CryptoDeleteTransactionBody) hiero.Status {
+	account, ok := ledger.accounts[deletion.GetDeleteAccountID().GetAccountNum()]
+	if !ok {
+		return hiero.StatusInvalidAccountID
+	}
+	if account.deleted {
+		return hiero.StatusAccountDeleted
+	}
+
+	transferAccount, ok := ledger.accounts[deletion.GetTransferAccountID().GetAccountNum()]
+	if !ok || transferAccount == account {
+		return hiero.StatusInvalidTransferAccountID
+	}
+
+	transferAccount.balance += account.balance
+	account.balance = 0
+	account.deleted = true
+	return hiero.StatusSuccess
+}
+
+func (ledger *Ledger) _CreateToken(create *services.TokenCreateTransactionBody, receipt *services.TransactionReceipt) hiero.Status {
+	treasury, ok := ledger.accounts[create.GetTreasury().GetAccountNum()]
+	if !ok || treasury.deleted {
+		return hiero.StatusInvalidTreasuryAccountForToken
+	}
+	if create.GetTokenType() != services.TokenType_FUNGIBLE_COMMON {
+		return hiero.StatusNotSupported
+	}
+
+	num := ledger._NextEntityNum()
+	ledger.tokens[num] = &_Token{
+		name:        create.GetName(),
+		symbol:      create.GetSymbol(),
+		decimals:    create.GetDecimals(),
+		tokenType:   create.GetTokenType(),
+		treasury:    create.GetTreasury().GetAccountNum(),
+		totalSupply: int64(create.GetInitialSupply()),
+		adminKey:    create.GetAdminKey(),
+		supplyKey:   create.GetSupplyKey(),
+	}
+	treasury.tokenBalances[num] = int64(create.GetInitialSupply())
+	receipt.TokenID = &services.TokenID{TokenNum: num}
+	return hiero.StatusSuccess
+}
+
+func (ledger *Ledger) _AssociateTokens(associate *services.TokenAssociateTransactionBody) hiero.Status {
+	account, ok := ledger.accounts[associate.GetAccount().GetAccountNum()]
+	if !ok {
+		return hiero.StatusInvalidAccountID
+	}
+
+	for _, tokenID := range associate.GetTokens() {
+		if _, ok := ledger.tokens[tokenID.GetTokenNum()]; !ok {
+			return hiero.StatusInvalidTokenID
+		}
+		if _, ok := account.tokenBalances[tokenID.GetTokenNum()]; ok {
+			return hiero.StatusTokenAlreadyAssociatedToAccount
+		}
+	}
+
+	for _, tokenID := range associate.GetTokens() {
+		account.tokenBalances[tokenID.GetTokenNum()] = 0
+	}
+
+	return hiero.StatusSuccess
+}
+
+func (ledger *Ledger) _MintToken(mint *services.TokenMintTransactionBody, receipt *services.TransactionReceipt) hiero.Status {
+	token, ok := ledger.tokens[mint.GetToken().GetTokenNum()]
+	if !ok {
+		return hiero.StatusInvalidTokenID
+	}
+	if token.supplyKey == nil {
+		return hiero.StatusTokenHasNoSupplyKey
+	}
+
+	token.totalSupply += int64(mint.GetAmount())
+	ledger.accounts[token.treasury].tokenBalances[mint.GetToken().GetTokenNum()] += int64(mint.GetAmount())
+	receipt.NewTotalSupply = uint64(token.totalSupply)
+	return hiero.StatusSuccess
+}
+
+func (ledger *Ledger) _CreateTopic(create *services.ConsensusCreateTopicTransactionBody, receipt *services.TransactionReceipt) hiero.Status {
+	num := ledger._NextEntityNum()
+	ledger.topics[num] = &_Topic{
+		memo:      create.GetMemo(),
+		adminKey:  create.GetAdminKey(),
+		submitKey: create.GetSubmitKey(),
+	}
+	receipt.TopicID = &services.TopicID{TopicNum: num}
+	return hiero.StatusSuccess
+}
+
+func (ledger *Ledger) _DeleteTopic(deletion *services.ConsensusDeleteTopicTransactionBody) hiero.Status {
+	topic, ok := ledger.topics[deletion.GetTopicID().GetTopicNum()]
+	if !ok || topic.deleted {
+		return hiero.StatusInvalidTopicID
+	}
+	if topic.adminKey == nil {
+		return hiero.StatusUnauthorized
+	}
+
+	topic.deleted = true
+	return hiero.StatusSuccess
+}
+
+func (ledger *Ledger) _SubmitMessage(submit *services.ConsensusSubmitMessageTransactionBody, receipt *services.TransactionReceipt) hiero.Status {
+	topicNum := submit.GetTopicID().GetTopicNum()
+	topic, ok := ledger.topics[topicNum]
+	if !ok || topic.deleted {
+		return hiero.StatusInvalidTopicID
+	}
+	if len(submit.GetMessage()) == 0 {
+		return hiero.StatusInvalidTopicMessage
+	}
+
+	topic.sequenceNumber++
+	topic.runningHash = _NextRunningHash(topic.runningHash, topicNum, topic.sequenceNumber, submit.GetMessage())
+
+	timestamp := ledger._ConsensusTimestamp()
+	topic.messages = append(topic.messages, &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: &services.Timestamp{Seconds: timestamp.Unix(), Nanos: int32(timestamp.Nanosecond())},
+		Message:            submit.GetMessage(),
+		RunningHash:        topic.runningHash,
+		SequenceNumber:     topic.sequenceNumber,
+		RunningHashVersion: 3,
+		ChunkInfo:          submit.GetChunkInfo(),
+	})
+	ledger._NotifyChanged()
+
+	receipt.TopicSequenceNumber = topic.sequenceNumber
+	receipt.TopicRunningHash = topic.runningHash
+	receipt.TopicRunningHashVersion = 3
+	return hiero.StatusSuccess
+}
+
+// _IsSignedBy reports whether the transaction carries a valid signature of the key. Only ED25519 and
+// ECDSA(secp256k1) keys are verified; key lists and threshold keys are accepted as signed.
+func _IsSignedBy(key *services.Key, signedTransaction *services.SignedTransaction) bool {
+	var publicKey hiero.PublicKey
+	var err error
+
+	switch k := key.GetKey().(type) {
+	case *services.Key_Ed25519:
+		publicKey, err = hiero.PublicKeyFromBytesEd25519(k.Ed25519)
+	case *services.Key_ECDSASecp256K1:
+		publicKey, err = hiero.PublicKeyFromBytesECDSA(k.ECDSASecp256K1)
+	default:
+		return true
+	}
+	if err != nil {
+		return false
+	}
+
+	for _, pair := range signedTransaction.GetSigMap().GetSigPair() {
+		if !bytes.Equal(pair.GetPubKeyPrefix(), publicKey.BytesRaw()) {
+			continue
+		}
+
+		var signature []byte
+		switch s := pair.GetSignature().(type) {
+		case *services.SignaturePair_Ed25519:
+			signature = s.Ed25519
+		case *services.SignaturePair_ECDSASecp256K1:
+			signature = s.ECDSASecp256K1
+		}
+
+		if publicKey.VerifySignedMessage(signedTransaction.GetBodyBytes(), signature) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func _TransactionResponse(status hiero.Status) *services.TransactionResponse {
+	return &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum(status)}
+}
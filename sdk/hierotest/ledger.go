@@ -0,0 +1,305 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// _FirstEntityNum is the number of the first account, token or topic created on the ledger.
+const _FirstEntityNum = 1001
+
+// TransactionHook decides the outcome of a transaction before the ledger applies it. It returns the
+// receipt status and true to take over the transaction, which is then not applied, or false to let the
+// ledger apply it.
+type TransactionHook func(body *services.TransactionBody) (hiero.Status, bool)
+
+// Ledger is the in-memory state shared by the nodes of a Network. Its methods script the state
+// directly, bypassing transactions, and are safe for concurrent use with the nodes.
+type Ledger struct {
+	mu sync.Mutex
+
+	nextEntityNum   int64
+	lastTimestamp   time.Time
+	receiptPolls    int
+	transactionHook TransactionHook
+
+	accounts map[int64]*_Account
+	tokens   map[int64]*_Token
+	topics   map[int64]*_Topic
+	receipts map[string]*_Receipt
+
+	// changed is closed and replaced every time a topic message is added, waking up subscribers.
+	changed chan struct{}
+}
+
+type _Account struct {
+	key           *services.Key
+	balance       int64
+	memo          string
+	deleted       bool
+	tokenBalances map[int64]int64
+}
+
+type _Token struct {
+	name        string
+	symbol      string
+	decimals    uint32
+	tokenType   services.TokenType
+	treasury    int64
+	totalSupply int64
+	adminKey    *services.Key
+	supplyKey   *services.Key
+}
+
+type _Topic struct {
+	memo           string
+	adminKey       *services.Key
+	submitKey      *services.Key
+	deleted        bool
+	sequenceNumber uint64
+	runningHash    []byte
+	messages       []*mirror.ConsensusTopicResponse
+}
+
+type _Receipt struct {
+	receipt *services.TransactionReceipt
+	// polls is the number of receipt queries still answered with UNKNOWN.
+	polls int
+}
+
+func _NewLedger() *Ledger {
+	return &Ledger{
+		nextEntityNum: _FirstEntityNum,
+		accounts:      map[int64]*_Account{},
+		tokens:        map[int64]*_Token{},
+		topics:        map[int64]*_Topic{},
+		receipts:      map[string]*_Receipt{},
+		changed:       make(chan struct{}),
+	}
+}
+
+// CreateAccount creates an account with the key and balance, and returns its ID.
+func (ledger *Ledger) CreateAccount(key hiero.Key, balance hiero.Hbar) (hiero.AccountID, error) {
+	pbKey, err := _KeyToProtobuf(key)
+	if err != nil {
+		return hiero.AccountID{}, err
+	}
+
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	num := ledger._NextEntityNum()
+	ledger.accounts[num] = &_Account{key: pbKey, balance: balance.AsTinybar(), tokenBalances: map[int64]int64{}}
+	return hiero.AccountID{Account: uint64(num)}, nil
+}
+
+// GetBalance returns the hbar balance of the account, and false if it does not exist.
+func (ledger *Ledger) GetBalance(accountID hiero.AccountID) (hiero.Hbar, bool) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	account, ok := ledger.accounts[int64(accountID.Account)]
+	if !ok {
+		return hiero.Hbar{}, false
+	}
+
+	return hiero.HbarFromTinybar(account.balance), true
+}
+
+// SetBalance sets the hbar balance of an existing account.
+func (ledger *Ledger) SetBalance(accountID hiero.AccountID, balance hiero.Hbar) error {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	account, ok := ledger.accounts[int64(accountID.Account)]
+	if !ok {
+		return fmt.Errorf("hierotest: account %s does not exist", accountID)
+	}
+
+	account.balance = balance.AsTinybar()
+	return nil
+}
+
+// CreateToken creates a fungible token whose initial supply is held by the treasury, and returns its ID.
+func (ledger *Ledger) CreateToken(name string, symbol string, decimals uint32, treasury hiero.AccountID, initialSupply uint64) (hiero.TokenID, error) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	account, ok := ledger.accounts[int64(treasury.Account)]
+	if !ok {
+		return hiero.TokenID{}, fmt.Errorf("hierotest: treasury account %s does not exist", treasury)
+	}
+
+	num := ledger._NextEntityNum()
+	ledger.tokens[num] = &_Token{
+		name:        name,
+		symbol:      symbol,
+		decimals:    decimals,
+		tokenType:   services.TokenType_FUNGIBLE_COMMON,
+		treasury:    int64(treasury.Account),
+		totalSupply: int64(initialSupply),
+	}
+	account.tokenBalances[num] = int64(initialSupply)
+	return hiero.TokenID{Token: uint64(num)}, nil
+}
+
+// GetTokenBalance returns the balance of the token held by the account, and false if the account
+// is not associated with the token.
+func (ledger *Ledger) GetTokenBalance(accountID hiero.AccountID, tokenID hiero.TokenID) (uint64, bool) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	account, ok := ledger.accounts[int64(accountID.Account)]
+	if !ok {
+		return 0, false
+	}
+
+	balance, ok := account.tokenBalances[int64(tokenID.Token)]
+	return uint64(balance), ok
+}
+
+// CreateTopic creates a topic anyone can submit messages to, and returns its ID.
+func (ledger *Ledger) CreateTopic(memo string) hiero.TopicID {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	num := ledger._NextEntityNum()
+	ledger.topics[num] = &_Topic{memo: memo}
+	return hiero.TopicID{Topic: uint64(num)}
+}
+
+// GetTopicMessages returns the messages submitted to the topic, one per chunk, in consensus order.
+func (ledger *Ledger) GetTopicMessages(topicID hiero.TopicID) []hiero.TopicMessage {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	topic, ok := ledger.topics[int64(topicID.Topic)]
+	if !ok {
+		return nil
+	}
+
+	messages := make([]hiero.TopicMessage, 0, len(topic.messages))
+	for _, message := range topic.messages {
+		messages = append(messages, hiero.TopicMessage{
+			ConsensusTimestamp: time.Unix(message.ConsensusTimestamp.Seconds, int64(message.ConsensusTimestamp.Nanos)),
+			Contents:           message.Message,
+			RunningHash:        message.RunningHash,
+			SequenceNumber:     message.SequenceNumber,
+		})
+	}
+
+	return messages
+}
+
+// GetReceiptStatus returns the receipt status of a transaction the ledger handled, and false if it
+// never reached the ledger.
+func (ledger *Ledger) GetReceiptStatus(transactionID hiero.TransactionID) (hiero.Status, bool) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	receipt, ok := ledger.receipts[_TransactionIDKey(_TransactionIDToProtobuf(transactionID))]
+	if !ok {
+		return hiero.StatusUnknown, false
+	}
+
+	return hiero.Status(receipt.receipt.Status), true
+}
+
+// SetReceiptPolls makes the receipts of the transactions handled from now on answer UNKNOWN to the
+// given number of receipt queries before the final receipt, as while waiting for consensus.
+func (ledger *Ledger) SetReceiptPolls(polls int) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	ledger.receiptPolls = polls
+}
+
+// SetTransactionHook sets the hook deciding the outcome of transactions before the ledger applies
+// them, nil to remove it.
+func (ledger *Ledger) SetTransactionHook(hook TransactionHook) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	ledger.transactionHook = hook
+}
+
+func (ledger *Ledger) _NextEntityNum() int64 {
+	num := ledger.nextEntityNum
+	ledger.nextEntityNum++
+	return num
+}
+
+// _ConsensusTimestamp returns the next consensus timestamp, strictly increasing.
+func (ledger *Ledger) _ConsensusTimestamp() time.Time {
+	now := time.Now()
+	if !now.After(ledger.lastTimestamp) {
+		now = ledger.lastTimestamp.Add(time.Nanosecond)
+	}
+	ledger.lastTimestamp = now
+	return now
+}
+
+func (ledger *Ledger) _Changed() <-chan struct{} {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	return ledger.changed
+}
+
+func (ledger *Ledger) _NotifyChanged() {
+	close(ledger.changed)
+	ledger.changed = make(chan struct{})
+}
+
+// _NextRunningHash chains the message into the running hash of the topic. It is a SHA-384 over the
+// previous hash, the topic, the sequence number and the message, not the exact network algorithm.
+func _NextRunningHash(previous []byte, topicNum int64, sequenceNumber uint64, message []byte) []byte {
+	hash := sha512.New384()
+	hash.Write(previous)
+
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(topicNum))
+	hash.Write(buf[:])
+	binary.BigEndian.PutUint64(buf[:], sequenceNumber)
+	hash.Write(buf[:])
+
+	hash.Write(message)
+	return hash.Sum(nil)
+}
+
+func _KeyToProtobuf(key hiero.Key) (*services.Key, error) {
+	bytes, err := hiero.KeyToBytes(key)
+	if err != nil {
+		return nil, err
+	}
+
+	pbKey := &services.Key{}
+	if err := protobuf.Unmarshal(bytes, pbKey); err != nil {
+		return nil, err
+	}
+
+	return pbKey, nil
+}
+
+func _TransactionIDToProtobuf(transactionID hiero.TransactionID) *services.TransactionID {
+	pb := &services.TransactionID{}
+	_ = protobuf.Unmarshal(transactionID.ToBytes(), pb)
+	return pb
+}
+
+func _TransactionIDKey(transactionID *services.TransactionID) string {
+	return fmt.Sprintf("%d.%d.%d@%d.%09d/%t/%d",
+		transactionID.GetAccountID().GetShardNum(), transactionID.GetAccountID().GetRealmNum(), transactionID.GetAccountID().GetAccountNum(),
+		transactionID.GetTransactionValidStart().GetSeconds(), transactionID.GetTransactionValidStart().GetNanos(),
+		transactionID.GetScheduled(), transactionID.GetNonce())
+}
@@ -0,0 +1,113 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// _MirrorServer streams the topic messages of the ledger, like the consensus service of a mirror node.
+type _MirrorServer struct {
+	mirror.UnimplementedConsensusServiceServer
+
+	listener net.Listener
+	server   *grpc.Server
+	ledger   *Ledger
+}
+
+func _StartMirrorServer(ledger *Ledger) (*_MirrorServer, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("hierotest: failed to listen for mirror node: %w", err)
+	}
+
+	server := &_MirrorServer{
+		listener: listener,
+		server:   _NewGrpcServer(),
+		ledger:   ledger,
+	}
+	mirror.RegisterConsensusServiceServer(server.server, server)
+
+	go func() {
+		_ = server.server.Serve(listener)
+	}()
+
+	return server, nil
+}
+
+// Address returns the address the mirror node listens on.
+func (server *_MirrorServer) Address() string {
+	return server.listener.Addr().String()
+}
+
+// SubscribeTopic sends the messages of the topic from the start time on, then the new messages as they
+// reach consensus, until the end time or the limit.
+func (server *_MirrorServer) SubscribeTopic(query *mirror.ConsensusTopicQuery, stream mirror.ConsensusService_SubscribeTopicServer) error {
+	topicNum := query.GetTopicID().GetTopicNum()
+	var sent uint64
+	var next int
+
+	for {
+		changed := server.ledger._Changed()
+
+		messages, ok := server.ledger._TopicMessagesFrom(topicNum, next)
+		if !ok {
+			return status.Errorf(codes.NotFound, "topic 0.0.%d does not exist", topicNum)
+		}
+		next += len(messages)
+
+		for _, message := range messages {
+			if _TimestampBefore(message.ConsensusTimestamp, query.GetConsensusStartTime()) {
+				continue
+			}
+			if query.GetConsensusEndTime() != nil && !_TimestampBefore(message.ConsensusTimestamp, query.GetConsensusEndTime()) {
+				return nil
+			}
+
+			if err := stream.Send(message); err != nil {
+				return err
+			}
+
+			sent++
+			if query.GetLimit() > 0 && sent >= query.GetLimit() {
+				return nil
+			}
+		}
+
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// _TopicMessagesFrom returns the messages of the topic from the index on, and false if it does not exist.
+func (ledger *Ledger) _TopicMessagesFrom(topicNum int64, from int) ([]*mirror.ConsensusTopicResponse, bool) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	topic, ok := ledger.topics[topicNum]
+	if !ok {
+		return nil, false
+	}
+
+	return append([]*mirror.ConsensusTopicResponse(nil), topic.messages[from:]...), true
+}
+
+func _TimestampBefore(timestamp *services.Timestamp, other *services.Timestamp) bool {
+	if other == nil {
+		return false
+	}
+	if timestamp.Seconds != other.Seconds {
+		return timestamp.Seconds < other.Seconds
+	}
+	return timestamp.Nanos < other.Nanos
+}
@@ -0,0 +1,238 @@
+// Package hierotest runs an in-process Hiero network for tests. Every node is a gRPC server backed by
+// an in-memory Ledger which tests can script directly, and a mirror node streams the topic messages
+// of the same ledger. Network.Client returns a *hiero.Client wired to the network.
+//
+// The network implements the common crypto, token and consensus transactions and queries, enough to
+// exercise application code without a real network or a solo deployment. Fees are not charged and
+// only the payer signature is verified.
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// OperatorAccountID is the account of the operator of the clients returned by Network.Client.
+var OperatorAccountID = hiero.AccountID{Account: 2}
+
+// _OperatorBalance is the initial hbar balance of the operator account.
+const _OperatorBalance = 50_000_000_000
+
+// Network is an in-process network of nodes and a mirror node sharing one ledger.
+type Network struct {
+	ledger      *Ledger
+	nodes       []*Node
+	mirror      *_MirrorServer
+	operatorKey hiero.PrivateKey
+
+	mu      sync.Mutex
+	clients []*hiero.Client
+	closed  bool
+}
+
+// Node is one consensus node of a Network.
+type Node struct {
+	accountID hiero.AccountID
+	listener  net.Listener
+	server    *grpc.Server
+	ledger    *Ledger
+
+	mu             sync.Mutex
+	injectedStatus hiero.Status
+	injectedCount  int
+}
+
+// NewNetwork starts a network of nodeCount nodes, with accounts 0.0.3 onwards, and a mirror node.
+// The operator account 0.0.2 is created with a new ED25519 key and 500 hbar. Close stops the servers.
+func NewNetwork(nodeCount int) (*Network, error) {
+	if nodeCount < 1 {
+		return nil, errors.New("hierotest: a network needs at least one node")
+	}
+
+	operatorKey, err := hiero.PrivateKeyGenerateEd25519()
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := _NewLedger()
+	pbKey, err := _KeyToProtobuf(operatorKey.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	ledger.accounts[int64(OperatorAccountID.Account)] = &_Account{
+		key:           pbKey,
+		balance:       _OperatorBalance,
+		tokenBalances: map[int64]int64{},
+	}
+
+	network := &Network{ledger: ledger, operatorKey: operatorKey}
+
+	for i := 0; i < nodeCount; i++ {
+		accountID := hiero.AccountID{Account: uint64(3 + i)}
+		ledger.accounts[int64(accountID.Account)] = &_Account{tokenBalances: map[int64]int64{}}
+
+		node, err := _StartNode(accountID, ledger)
+		if err != nil {
+			network.Close()
+			return nil, err
+		}
+		network.nodes = append(network.nodes, node)
+	}
+
+	network.mirror, err = _StartMirrorServer(ledger)
+	if err != nil {
+		network.Close()
+		return nil, err
+	}
+
+	return network, nil
+}
+
+// Client returns a new client for the network, with the operator set. Close closes it with the network.
+func (network *Network) Client() (*hiero.Client, error) {
+	nodes := make(map[string]hiero.AccountID, len(network.nodes))
+	for _, node := range network.nodes {
+		nodes[node.Address()] = node.accountID
+	}
+
+	client, err := hiero.ClientForNetworkV2(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	client.SetMirrorNetwork([]string{network.mirror.Address()})
+	client.SetOperator(OperatorAccountID, network.operatorKey)
+	client.SetMinBackoff(10 * time.Millisecond)
+	client.SetMaxBackoff(100 * time.Millisecond)
+	client.SetNodeMinBackoff(10 * time.Millisecond)
+	client.SetNodeMaxBackoff(100 * time.Millisecond)
+	client.SetMinNodeReadmitTime(10 * time.Millisecond)
+	client.SetMaxNodeReadmitTime(100 * time.Millisecond)
+
+	network.mu.Lock()
+	defer network.mu.Unlock()
+
+	if network.closed {
+		_ = client.Close()
+		return nil, errors.New("hierotest: network is closed")
+	}
+
+	network.clients = append(network.clients, client)
+	return client, nil
+}
+
+// OperatorKey returns the private key of the operator account.
+func (network *Network) OperatorKey() hiero.PrivateKey {
+	return network.operatorKey
+}
+
+// Ledger returns the ledger shared by the nodes.
+func (network *Network) Ledger() *Ledger {
+	return network.ledger
+}
+
+// Nodes returns the nodes of the network.
+func (network *Network) Nodes() []*Node {
+	return network.nodes
+}
+
+// MirrorAddress returns the address of the mirror node.
+func (network *Network) MirrorAddress() string {
+	return network.mirror.Address()
+}
+
+// Close closes the clients returned by Client and stops the servers.
+func (network *Network) Close() {
+	network.mu.Lock()
+	network.closed = true
+	clients := network.clients
+	network.clients = nil
+	network.mu.Unlock()
+
+	for _, client := range clients {
+		_ = client.Close()
+	}
+
+	for _, node := range network.nodes {
+		node.server.Stop()
+	}
+
+	if network.mirror != nil {
+		network.mirror.server.Stop()
+	}
+}
+
+func _StartNode(accountID hiero.AccountID, ledger *Ledger) (*Node, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("hierotest: failed to listen for node %s: %w", accountID, err)
+	}
+
+	node := &Node{
+		accountID: accountID,
+		listener:  listener,
+		server:    _NewGrpcServer(),
+		ledger:    ledger,
+	}
+
+	services.RegisterCryptoServiceServer(node.server, &_CryptoService{node: node})
+	services.RegisterTokenServiceServer(node.server, &_TokenService{node: node})
+	services.RegisterConsensusServiceServer(node.server, &_ConsensusService{node: node})
+
+	go func() {
+		_ = node.server.Serve(listener)
+	}()
+
+	return node, nil
+}
+
+// _NewGrpcServer returns a server accepting the keepalive pings of the SDK.
+func _NewGrpcServer() *grpc.Server {
+	return grpc.NewServer(grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             time.Second,
+		PermitWithoutStream: true,
+	}))
+}
+
+// AccountID returns the account of the node.
+func (node *Node) AccountID() hiero.AccountID {
+	return node.accountID
+}
+
+// Address returns the address the node listens on.
+func (node *Node) Address() string {
+	return node.listener.Addr().String()
+}
+
+// InjectStatus makes the node answer the next count transactions and queries with the precheck
+// status instead of handling them, for example StatusBusy or StatusPlatformNotActive.
+func (node *Node) InjectStatus(status hiero.Status, count int) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.injectedStatus = status
+	node.injectedCount = count
+}
+
+// _TakeInjectedStatus returns the injected status for the current request, if any is left.
+func (node *Node) _TakeInjectedStatus() (hiero.Status, bool) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	if node.injectedCount <= 0 {
+		return hiero.StatusOk, false
+	}
+
+	node.injectedCount--
+	return node.injectedStatus, true
+}
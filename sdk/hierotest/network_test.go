@@ -0,0 +1,288 @@
+//go:build all || unit
+// +build all unit
+
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewTestNetwork(t *testing.T, nodeCount int) (*Network, *hiero.Client) {
+	network, err := NewNetwork(nodeCount)
+	require.NoError(t, err)
+	t.Cleanup(network.Close)
+
+	client, err := network.Client()
+	require.NoError(t, err)
+
+	return network, client
+}
+
+func TestUnitHierotestTransfer(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 2)
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	resp, err := hiero.NewAccountCreateTransaction().
+		SetKeyWithoutAlias(key.PublicKey()).
+		SetInitialBalance(hiero.NewHbar(10)).
+		Execute(client)
+	require.NoError(t, err)
+
+	receipt, err := resp.GetReceipt(client)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.AccountID)
+	assert.Equal(t, hiero.AccountID{Account: _FirstEntityNum}, *receipt.AccountID)
+
+	resp, err = hiero.NewTransferTransaction().
+		AddHbarTransfer(OperatorAccountID, hiero.NewHbar(-5)).
+		AddHbarTransfer(*receipt.AccountID, hiero.NewHbar(5)).
+		Execute(client)
+	require.NoError(t, err)
+
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+
+	balance, err := hiero.NewAccountBalanceQuery().SetAccountID(*receipt.AccountID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, hiero.NewHbar(15), balance.Hbars)
+
+	ledgerBalance, ok := network.Ledger().GetBalance(*receipt.AccountID)
+	require.True(t, ok)
+	assert.Equal(t, hiero.NewHbar(15), ledgerBalance)
+
+	info, err := hiero.NewAccountInfoQuery().SetAccountID(*receipt.AccountID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey().String(), info.Key.String())
+}
+
+func TestUnitHierotestTransferInsufficientBalance(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	accountID, err := network.Ledger().CreateAccount(key.PublicKey(), hiero.NewHbar(1))
+	require.NoError(t, err)
+
+	resp, err := hiero.NewTransferTransaction().
+		AddHbarTransfer(accountID, hiero.NewHbar(-2)).
+		AddHbarTransfer(OperatorAccountID, hiero.NewHbar(2)).
+		FreezeWith(client)
+	require.NoError(t, err)
+
+	response, err := resp.Sign(key).Execute(client)
+	require.NoError(t, err)
+
+	_, err = response.GetReceipt(client)
+	require.ErrorContains(t, err, hiero.StatusInsufficientAccountBalance.String())
+
+	balance, _ := network.Ledger().GetBalance(accountID)
+	assert.Equal(t, hiero.NewHbar(1), balance)
+}
+
+func TestUnitHierotestInvalidSignature(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	accountID, err := network.Ledger().CreateAccount(key.PublicKey(), hiero.NewHbar(1))
+	require.NoError(t, err)
+
+	otherKey, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	client.SetOperator(accountID, otherKey)
+
+	_, err = hiero.NewTransferTransaction().
+		AddHbarTransfer(accountID, hiero.NewHbar(-1)).
+		AddHbarTransfer(OperatorAccountID, hiero.NewHbar(1)).
+		Execute(client)
+	require.ErrorContains(t, err, hiero.StatusInvalidSignature.String())
+}
+
+func TestUnitHierotestInjectedStatusIsRetried(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+	network.Nodes()[0].InjectStatus(hiero.StatusBusy, 2)
+
+	balance, err := hiero.NewAccountBalanceQuery().SetAccountID(OperatorAccountID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, hiero.HbarFromTinybar(_OperatorBalance), balance.Hbars)
+
+	network.Nodes()[0].InjectStatus(hiero.StatusPlatformNotActive, 1)
+
+	resp, err := hiero.NewTransferTransaction().
+		AddHbarTransfer(OperatorAccountID, hiero.NewHbar(-1)).
+		AddHbarTransfer(network.Nodes()[0].AccountID(), hiero.NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+}
+
+func TestUnitHierotestInjectedStatusFails(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+	network.Nodes()[0].InjectStatus(hiero.StatusInvalidTransaction, 1)
+
+	_, err := hiero.NewTransferTransaction().
+		AddHbarTransfer(OperatorAccountID, hiero.NewHbar(-1)).
+		AddHbarTransfer(network.Nodes()[0].AccountID(), hiero.NewHbar(1)).
+		Execute(client)
+	require.ErrorContains(t, err, hiero.StatusInvalidTransaction.String())
+}
+
+func TestUnitHierotestReceiptPollsAndHook(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+	network.Ledger().SetReceiptPolls(2)
+	network.Ledger().SetTransactionHook(func(body *services.TransactionBody) (hiero.Status, bool) {
+		return hiero.StatusAccountFrozenForToken, body.GetCryptoTransfer() != nil
+	})
+
+	resp, err := hiero.NewTransferTransaction().
+		AddHbarTransfer(OperatorAccountID, hiero.NewHbar(-1)).
+		AddHbarTransfer(network.Nodes()[0].AccountID(), hiero.NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	receipt, err := resp.SetValidateStatus(false).GetReceipt(client)
+	require.NoError(t, err)
+	assert.Equal(t, hiero.StatusAccountFrozenForToken, receipt.Status)
+
+	status, ok := network.Ledger().GetReceiptStatus(resp.TransactionID)
+	require.True(t, ok)
+	assert.Equal(t, hiero.StatusAccountFrozenForToken, status)
+
+	balance, _ := network.Ledger().GetBalance(OperatorAccountID)
+	assert.Equal(t, hiero.HbarFromTinybar(_OperatorBalance), balance)
+}
+
+func TestUnitHierotestToken(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	accountID, err := network.Ledger().CreateAccount(key.PublicKey(), hiero.NewHbar(1))
+	require.NoError(t, err)
+	tokenID, err := network.Ledger().CreateToken("Test", "TST", 2, OperatorAccountID, 1000)
+	require.NoError(t, err)
+
+	resp, err := hiero.NewTransferTransaction().
+		AddTokenTransfer(tokenID, OperatorAccountID, -10).
+		AddTokenTransfer(tokenID, accountID, 10).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.ErrorContains(t, err, hiero.StatusTokenNotAssociatedToAccount.String())
+
+	associate, err := hiero.NewTokenAssociateTransaction().
+		SetAccountID(accountID).
+		SetTokenIDs(tokenID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	resp, err = associate.Sign(key).Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+
+	resp, err = hiero.NewTransferTransaction().
+		AddTokenTransfer(tokenID, OperatorAccountID, -10).
+		AddTokenTransfer(tokenID, accountID, 10).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+
+	balance, ok := network.Ledger().GetTokenBalance(accountID, tokenID)
+	require.True(t, ok)
+	assert.Equal(t, uint64(10), balance)
+
+	info, err := hiero.NewTokenInfoQuery().SetTokenID(tokenID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "TST", info.Symbol)
+	assert.Equal(t, uint64(1000), info.TotalSupply)
+}
+
+func TestUnitHierotestTopic(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+
+	resp, err := hiero.NewTopicCreateTransaction().SetTopicMemo("memo").Execute(client)
+	require.NoError(t, err)
+	receipt, err := resp.GetReceipt(client)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.TopicID)
+	topicID := *receipt.TopicID
+
+	received := make(chan hiero.TopicMessage, 3)
+	handle, err := hiero.NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetStartTime(time.Unix(0, 0)).
+		Subscribe(client, func(message hiero.TopicMessage) {
+			received <- message
+		})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	for _, message := range []string{"one", "two", "three"} {
+		resp, err := hiero.NewTopicMessageSubmitTransaction().
+			SetTopicID(topicID).
+			SetMessage([]byte(message)).
+			Execute(client)
+		require.NoError(t, err)
+		receipt, err := resp.GetReceipt(client)
+		require.NoError(t, err)
+		assert.NotEmpty(t, receipt.TopicRunningHash)
+	}
+
+	for i, expected := range []string{"one", "two", "three"} {
+		select {
+		case message := <-received:
+			assert.Equal(t, expected, string(message.Contents))
+			assert.Equal(t, uint64(i+1), message.SequenceNumber)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for message %d", i+1)
+		}
+	}
+
+	info, err := hiero.NewTopicInfoQuery().SetTopicID(topicID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "memo", info.TopicMemo)
+	assert.Equal(t, uint64(3), info.SequenceNumber)
+	assert.Len(t, network.Ledger().GetTopicMessages(topicID), 3)
+}
+
+func TestUnitHierotestCloseClosesClients(t *testing.T) {
+	network, err := NewNetwork(1)
+	require.NoError(t, err)
+
+	_, err = network.Client()
+	require.NoError(t, err)
+
+	network.Close()
+
+	_, err = network.Client()
+	require.Error(t, err)
+}
@@ -0,0 +1,227 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+type _CryptoService struct {
+	services.UnimplementedCryptoServiceServer
+	node *Node
+}
+
+type _TokenService struct {
+	services.UnimplementedTokenServiceServer
+	node *Node
+}
+
+type _ConsensusService struct {
+	services.UnimplementedConsensusServiceServer
+	node *Node
+}
+
+func (service *_CryptoService) CreateAccount(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._HandleTransaction(transaction)
+}
+
+func (service *_CryptoService) CryptoTransfer(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._HandleTransaction(transaction)
+}
+
+func (service *_CryptoService) CryptoDelete(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._HandleTransaction(transaction)
+}
+
+func (service *_CryptoService) CryptoGetBalance(_ context.Context, query *services.Query) (*services.Response, error) {
+	request := query.GetCryptogetAccountBalance()
+	header, account := service.node._QueryAccount(request.GetHeader(), request.GetAccountID())
+
+	response := &services.CryptoGetAccountBalanceResponse{Header: header, AccountID: request.GetAccountID()}
+	if account != nil {
+		response.Balance = uint64(account.balance)
+	}
+
+	return &services.Response{Response: &services.Response_CryptogetAccountBalance{CryptogetAccountBalance: response}}, nil
+}
+
+func (service *_CryptoService) GetAccountInfo(_ context.Context, query *services.Query) (*services.Response, error) {
+	request := query.GetCryptoGetInfo()
+	header, account := service.node._QueryAccount(request.GetHeader(), request.GetAccountID())
+
+	response := &services.CryptoGetInfoResponse{Header: header}
+	if account != nil {
+		response.AccountInfo = &services.CryptoGetInfoResponse_AccountInfo{
+			AccountID: request.GetAccountID(),
+			Key:       account.key,
+			Balance:   uint64(account.balance),
+			Memo:      account.memo,
+		}
+	}
+
+	return &services.Response{Response: &services.Response_CryptoGetInfo{CryptoGetInfo: response}}, nil
+}
+
+func (service *_CryptoService) GetTransactionReceipts(_ context.Context, query *services.Query) (*services.Response, error) {
+	request := query.GetTransactionGetReceipt()
+	response := &services.TransactionGetReceiptResponse{}
+
+	if status, ok := service.node._TakeInjectedStatus(); ok {
+		response.Header = _ResponseHeader(status, request.GetHeader())
+	} else {
+		response.Header = _ResponseHeader(hiero.StatusOk, request.GetHeader())
+		response.Receipt = service.node.ledger._PollReceipt(request.GetTransactionID())
+		if response.Receipt == nil {
+			response.Header = _ResponseHeader(hiero.StatusReceiptNotFound, request.GetHeader())
+		}
+	}
+
+	return &services.Response{Response: &services.Response_TransactionGetReceipt{TransactionGetReceipt: response}}, nil
+}
+
+func (service *_TokenService) CreateToken(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._HandleTransaction(transaction)
+}
+
+func (service *_TokenService) AssociateTokens(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._HandleTransaction(transaction)
+}
+
+func (service *_TokenService) MintToken(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._HandleTransaction(transaction)
+}
+
+func (service *_TokenService) GetTokenInfo(_ context.Context, query *services.Query) (*services.Response, error) {
+	request := query.GetTokenGetInfo()
+	response := &services.TokenGetInfoResponse{}
+
+	status, ok := service.node._TakeInjectedStatus()
+	if !ok {
+		status = hiero.StatusOk
+	}
+
+	if status == hiero.StatusOk {
+		ledger := service.node.ledger
+		ledger.mu.Lock()
+		token, exists := ledger.tokens[request.GetToken().GetTokenNum()]
+		if exists {
+			response.TokenInfo = &services.TokenInfo{
+				TokenId:     request.GetToken(),
+				Name:        token.name,
+				Symbol:      token.symbol,
+				Decimals:    token.decimals,
+				TotalSupply: uint64(token.totalSupply),
+				Treasury:    &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: token.treasury}},
+				AdminKey:    token.adminKey,
+				SupplyKey:   token.supplyKey,
+				TokenType:   token.tokenType,
+			}
+		}
+		ledger.mu.Unlock()
+
+		if !exists {
+			status = hiero.StatusInvalidTokenID
+		}
+	}
+
+	response.Header = _ResponseHeader(status, request.GetHeader())
+	return &services.Response{Response: &services.Response_TokenGetInfo{TokenGetInfo: response}}, nil
+}
+
+func (service *_ConsensusService) CreateTopic(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._HandleTransaction(transaction)
+}
+
+func (service *_ConsensusService) DeleteTopic(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._HandleTransaction(transaction)
+}
+
+func (service *_ConsensusService) SubmitMessage(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._HandleTransaction(transaction)
+}
+
+func (service *_ConsensusService) GetTopicInfo(_ context.Context, query *services.Query) (*services.Response, error) {
+	request := query.GetConsensusGetTopicInfo()
+	response := &services.ConsensusGetTopicInfoResponse{TopicID: request.GetTopicID()}
+
+	status, ok := service.node._TakeInjectedStatus()
+	if !ok {
+		status = hiero.StatusOk
+	}
+
+	if status == hiero.StatusOk {
+		ledger := service.node.ledger
+		ledger.mu.Lock()
+		topic, exists := ledger.topics[request.GetTopicID().GetTopicNum()]
+		if exists && !topic.deleted {
+			response.TopicInfo = &services.ConsensusTopicInfo{
+				Memo:           topic.memo,
+				RunningHash:    topic.runningHash,
+				SequenceNumber: topic.sequenceNumber,
+				AdminKey:       topic.adminKey,
+				SubmitKey:      topic.submitKey,
+			}
+		}
+		ledger.mu.Unlock()
+
+		if response.TopicInfo == nil {
+			status = hiero.StatusInvalidTopicID
+		}
+	}
+
+	response.Header = _ResponseHeader(status, request.GetHeader())
+	return &services.Response{Response: &services.Response_ConsensusGetTopicInfo{ConsensusGetTopicInfo: response}}, nil
+}
+
+// _QueryAccount returns the response header of a query about the account, and the account if the
+// query succeeds.
+func (node *Node) _QueryAccount(header *services.QueryHeader, accountID *services.AccountID) (*services.ResponseHeader, *_Account) {
+	if status, ok := node._TakeInjectedStatus(); ok {
+		return _ResponseHeader(status, header), nil
+	}
+
+	node.ledger.mu.Lock()
+	defer node.ledger.mu.Unlock()
+
+	account, ok := node.ledger.accounts[accountID.GetAccountNum()]
+	if !ok {
+		return _ResponseHeader(hiero.StatusInvalidAccountID, header), nil
+	}
+	if account.deleted {
+		return _ResponseHeader(hiero.StatusAccountDeleted, header), nil
+	}
+
+	copied := *account
+	return _ResponseHeader(hiero.StatusOk, header), &copied
+}
+
+// _PollReceipt returns the receipt of the transaction as seen by one receipt query, nil if the ledger
+// never handled it.
+func (ledger *Ledger) _PollReceipt(transactionID *services.TransactionID) *services.TransactionReceipt {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	receipt, ok := ledger.receipts[_TransactionIDKey(transactionID)]
+	if !ok {
+		return nil
+	}
+
+	if receipt.polls > 0 {
+		receipt.polls--
+		return &services.TransactionReceipt{Status: services.ResponseCodeEnum_UNKNOWN}
+	}
+
+	return receipt.receipt
+}
+
+// _ResponseHeader answers a query with the precheck status. Queries are free, so a cost query is
+// answered with a zero cost.
+func _ResponseHeader(status hiero.Status, header *services.QueryHeader) *services.ResponseHeader {
+	return &services.ResponseHeader{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum(status),
+		ResponseType:                header.GetResponseType(),
+	}
+}
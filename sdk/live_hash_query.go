@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -59,12 +60,17 @@ func (q *LiveHashQuery) GetGetHash() []byte {
 }
 
 func (q *LiveHashQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *LiveHashQuery) ExecuteWithContext(ctx context.Context, client *Client) (LiveHash, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return LiveHash{}, err
@@ -126,6 +132,12 @@ func (q *LiveHashQuery) SetLogLevel(level LogLevel) *LiveHashQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *LiveHashQuery) SetRetryPolicy(policy RetryPolicy) *LiveHashQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *LiveHashQuery) getMethod(channel *_Channel) _Method {
@@ -25,6 +25,7 @@ type _ManagedNetwork struct {
 	minNodeReadmitPeriod   time.Duration
 	maxNodeReadmitPeriod   time.Duration
 	earliestReadmitTime    time.Time
+	grpcOptions            *_GrpcOptions
 }
 
 func _NewManagedNetwork() _ManagedNetwork {
@@ -42,6 +43,7 @@ func _NewManagedNetwork() _ManagedNetwork {
 		verifyCertificate:      false,
 		minNodeReadmitPeriod:   8 * time.Second,
 		maxNodeReadmitPeriod:   1 * time.Hour,
+		grpcOptions:            _NewGrpcOptions(),
 	}
 }
 
@@ -84,6 +86,7 @@ func (mn *_ManagedNetwork) _SetNetwork(network map[string]_IManagedNode) error {
 		newNodes = append(newNodes, value)
 	}
 
+	mn._ShareGrpcOptions(newNodes)
 	newNetwork, newHealthyNodes := _CreateNetworkFromNodes(newNodes)
 
 	mn.nodes = newNodes
@@ -93,6 +96,15 @@ func (mn *_ManagedNetwork) _SetNetwork(network map[string]_IManagedNode) error {
 	return nil
 }
 
+// _ShareGrpcOptions makes the nodes open their connections with the gRPC options of the network
+func (mn *_ManagedNetwork) _ShareGrpcOptions(nodes []_IManagedNode) {
+	for _, node := range nodes {
+		if managed := node._GetManagedNode(); managed != nil && managed.grpcOptions != mn.grpcOptions {
+			managed.grpcOptions = mn.grpcOptions
+		}
+	}
+}
+
 func (mn *_ManagedNetwork) _ReadmitNodes() {
 	now := time.Now()
 
@@ -128,6 +140,25 @@ func (mn *_ManagedNetwork) _ReadmitNodes() {
 	}
 }
 
+// _IncreaseBackoff backs the node off after a failed call and takes it out of the healthy nodes until it is readmitted
+func (mn *_ManagedNetwork) _IncreaseBackoff(node _IManagedNode) {
+	mn.healthyNodesMutex.Lock()
+	defer mn.healthyNodesMutex.Unlock()
+	node._IncreaseBackoff()
+
+	index := -1
+	for i, healthyNode := range mn.healthyNodes {
+		if node == healthyNode {
+			index = i
+			break
+		}
+	}
+
+	if index >= 0 && index < len(mn.healthyNodes) {
+		mn.healthyNodes = append(mn.healthyNodes[:index], mn.healthyNodes[index+1:]...)
+	}
+}
+
 func (mn *_ManagedNetwork) _GetNumberOfNodesForTransaction() int { // nolint
 	mn._ReadmitNodes()
 	if mn.maxNodesPerTransaction != nil {
@@ -175,18 +206,18 @@ func (mn *_ManagedNetwork) _SetMinBackoff(minBackoff time.Duration) {
 	}
 }
 
-func (mn *_ManagedNetwork) _GetNode() _IManagedNode {
+func (mn *_ManagedNetwork) _GetNode() (_IManagedNode, error) {
 	mn._ReadmitNodes()
 	mn.healthyNodesMutex.RLock()
 	defer mn.healthyNodesMutex.RUnlock()
 
 	if len(mn.healthyNodes) == 0 {
-		panic("failed to find a healthy working node")
+		return nil, errNoHealthyNodes
 	}
 
 	bg := big.NewInt(int64(len(mn.healthyNodes)))
 	index, _ := rand.Int(rand.Reader, bg)
-	return mn.healthyNodes[index.Int64()]
+	return mn.healthyNodes[index.Int64()], nil
 }
 
 func (mn *_ManagedNetwork) _GetMinBackoff() time.Duration {
@@ -261,6 +292,7 @@ func (mn *_ManagedNetwork) _SetTransportSecurity(transportSecurity bool) (err er
 			}
 		}
 
+		mn._ShareGrpcOptions(newNodes)
 		newNetwork, newHealthyNodes := _CreateNetworkFromNodes(newNodes)
 
 		mn.nodes = newNodes
@@ -561,7 +561,8 @@ func TestUnitGetNode(t *testing.T) {
 	require.NotEqual(t, 0, len(mn.healthyNodes))
 
 	// Get a random node from the managed network
-	node := mn._GetNode()
+	node, err := mn._GetNode()
+	require.NoError(t, err)
 
 	// Check if the returned node is not nil
 	require.NotNil(t, node)
@@ -577,7 +578,7 @@ func TestUnitGetNode(t *testing.T) {
 	require.True(t, found, "The returned node should be one of the healthy nodes in the managed network")
 }
 
-func TestUnitGetNodePanicNoHealthyNodes(t *testing.T) {
+func TestUnitGetNodeNoHealthyNodes(t *testing.T) {
 	t.Parallel()
 
 	mn := _NewManagedNetwork()
@@ -599,15 +600,8 @@ func TestUnitGetNodePanicNoHealthyNodes(t *testing.T) {
 	// Ensure that there are no healthy nodes in the network
 	require.Equal(t, 0, len(mn.healthyNodes))
 
-	// Check if calling _GetNode() panics when there are no healthy nodes
-	defer func() {
-		if r := recover(); r != nil {
-			panicValue, ok := r.(string)
-			require.True(t, ok, "Panic value should be a string")
-			require.Equal(t, "failed to find a healthy working node", panicValue)
-		}
-	}()
-
-	mn._GetNode()
-	require.Fail(t, "Expected _GetNode to panic")
+	// Check if calling _GetNode() returns an error when there are no healthy nodes
+	node, err := mn._GetNode()
+	require.ErrorIs(t, err, errNoHealthyNodes)
+	require.Nil(t, node)
 }
@@ -40,9 +40,17 @@ type _ManagedNode struct {
 	maxBackoff         time.Duration
 	badGrpcStatusCount int64
 	readmitTime        *time.Time
-	mutex              sync.RWMutex
+	// latency is the exponentially weighted moving average of the round trips of the gRPC calls to the node
+	latency  time.Duration
+	inFlight int64
+	// grpcOptions are the gRPC dial options and interceptors of the network of the node
+	grpcOptions *_GrpcOptions
+	mutex       sync.RWMutex
 }
 
+// latencyWeight is the weight of the latest round trip in the latency average of a node
+const latencyWeight = 0.3
+
 func (node *_ManagedNode) _GetAttempts() int64 {
 	node.mutex.RLock()
 	defer node.mutex.RUnlock()
@@ -151,3 +159,82 @@ func (node *_ManagedNode) _GetUseCount() int64 {
 func (node *_ManagedNode) _GetLastUsed() time.Time {
 	return node.lastUsed
 }
+
+// _RecordLatency adds the round trip of a gRPC call to the latency average of the node
+func (node *_ManagedNode) _RecordLatency(latency time.Duration) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	if node.latency == 0 {
+		node.latency = latency
+		return
+	}
+	node.latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(node.latency))
+}
+
+// _GetLatency returns the latency average of the node, 0 before its first call
+func (node *_ManagedNode) _GetLatency() time.Duration {
+	node.mutex.RLock()
+	defer node.mutex.RUnlock()
+	return node.latency
+}
+
+// _StartRequest counts a gRPC call to the node as in flight until _EndRequest
+func (node *_ManagedNode) _StartRequest() {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	node.inFlight++
+}
+
+func (node *_ManagedNode) _EndRequest() {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	node.inFlight--
+}
+
+// _GetInFlight returns how many gRPC calls to the node are in flight
+func (node *_ManagedNode) _GetInFlight() int64 {
+	node.mutex.RLock()
+	defer node.mutex.RUnlock()
+	return node.inFlight
+}
+
+// _Quarantine takes the node out of use until the readmit time, as if its calls had been failing
+func (node *_ManagedNode) _Quarantine(until time.Time) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	node.readmitTime = &until
+}
+
+// _Readmit makes the node healthy again with its minimum backoff
+func (node *_ManagedNode) _Readmit() {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	now := time.Now()
+	node.readmitTime = &now
+	node.currentBackoff = node.minBackoff
+}
+
+// _GetHealth returns a snapshot of the health of the node
+func (node *_ManagedNode) _GetHealth() NodeHealth {
+	node.mutex.RLock()
+	defer node.mutex.RUnlock()
+
+	health := NodeHealth{
+		Address:        node._GetAddress(),
+		Healthy:        node.readmitTime == nil || node.readmitTime.Before(time.Now()),
+		CurrentBackoff: node.currentBackoff,
+		ErrorCount:     node.badGrpcStatusCount,
+		UseCount:       node.useCount,
+		LastUsed:       node.lastUsed,
+		AverageLatency: node.latency,
+		InFlight:       node.inFlight,
+	}
+	if node.readmitTime != nil {
+		readmitTime := *node.readmitTime
+		health.ReadmitTime = &readmitTime
+	}
+
+	return health
+}
@@ -4,10 +4,20 @@ package hiero
 
 import (
 	"math/rand"
+	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
+// MirrorNodeEndpoint is a mirror node with the address of its gRPC API, e.g. "mainnet.mirrornode.hedera.com:443",
+// and the base URL of its REST API, e.g. "https://mainnet.mirrornode.hedera.com". When RestURL is empty it is derived
+// from the gRPC address.
+type MirrorNodeEndpoint struct {
+	GrpcAddress string
+	RestURL     string
+}
+
 type _MirrorNetwork struct {
 	_ManagedNetwork
 }
@@ -19,14 +29,43 @@ func _NewMirrorNetwork() *_MirrorNetwork {
 }
 
 func (network *_MirrorNetwork) _SetNetwork(newNetwork []string) (err error) {
-	newMirrorNetwork := make(map[string]_IManagedNode)
+	endpoints := make([]MirrorNodeEndpoint, 0, len(newNetwork))
 	for _, url := range newNetwork {
-		if newMirrorNetwork[url], err = _NewMirrorNode(url); err != nil {
+		endpoints = append(endpoints, MirrorNodeEndpoint{GrpcAddress: url})
+	}
+
+	return network._SetEndpoints(endpoints)
+}
+
+func (network *_MirrorNetwork) _SetEndpoints(endpoints []MirrorNodeEndpoint) (err error) {
+	newMirrorNetwork := make(map[string]_IManagedNode)
+	restURLs := make(map[string]string)
+	for _, endpoint := range endpoints {
+		node, err := _NewMirrorNode(endpoint.GrpcAddress)
+		if err != nil {
 			return err
 		}
+		// The new nodes are given their REST URL before they are published
+		node.restURL = _TrimMirrorNodeBaseURL(endpoint.RestURL)
+		newMirrorNetwork[endpoint.GrpcAddress] = node
+		restURLs[node._GetKey()] = node.restURL
+	}
+
+	// The nodes are replaced under the lock, as they may be in use
+	network.healthyNodesMutex.Lock()
+	defer network.healthyNodesMutex.Unlock()
+	if err = network._ManagedNetwork._SetNetwork(newMirrorNetwork); err != nil {
+		return err
+	}
+
+	// The nodes kept from the previous network take the REST URL they are given now
+	for _, node := range network.nodes {
+		if node, ok := node.(*_MirrorNode); ok {
+			node.restURL = restURLs[node._GetKey()]
+		}
 	}
 
-	return network._ManagedNetwork._SetNetwork(newMirrorNetwork)
+	return nil
 }
 
 func (network *_MirrorNetwork) _GetNetwork() []string {
@@ -44,14 +83,114 @@ func (network *_MirrorNetwork) _SetTransportSecurity(transportSecurity bool) *_M
 	return network
 }
 
+// _GetEndpoints returns the gRPC address and REST URL of every mirror node, ordered by gRPC address
+func (network *_MirrorNetwork) _GetEndpoints() []MirrorNodeEndpoint {
+	network.healthyNodesMutex.RLock()
+	defer network.healthyNodesMutex.RUnlock()
+
+	endpoints := make([]MirrorNodeEndpoint, 0, len(network.nodes))
+	for _, node := range network.nodes {
+		if node, ok := node.(*_MirrorNode); ok {
+			endpoints = append(endpoints, MirrorNodeEndpoint{GrpcAddress: node._GetAddress(), RestURL: node.restURL})
+		}
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].GrpcAddress < endpoints[j].GrpcAddress
+	})
+
+	return endpoints
+}
+
+// _GetRestURL returns the base URL of the REST API of the mirror node, read under the lock guarding its change
+func (network *_MirrorNetwork) _GetRestURL(node *_MirrorNode, ledgerID *LedgerID, localPort string) (string, error) {
+	network.healthyNodesMutex.RLock()
+	defer network.healthyNodesMutex.RUnlock()
+
+	return node._GetRestURL(ledgerID, localPort)
+}
+
+// _GetNextMirrorNode returns the mirror node to use for a request, a random healthy one when there is one
 func (network *_MirrorNetwork) _GetNextMirrorNode() (*_MirrorNode, error) {
-	if len(network.healthyNodes) == 0 {
+	nodes := network._GetMirrorNodes()
+	if len(nodes) == 0 {
 		return nil, errors.New("no healthy nodes")
 	}
 
-	node := network.healthyNodes[rand.Intn(len(network.healthyNodes))] // nolint
-	if node, ok := node.(*_MirrorNode); ok {
-		return node, nil
+	return nodes[0], nil
+}
+
+// _GetNextMirrorNodeAfter returns the mirror node to move to after the node failed, the first in the order of
+// _GetMirrorNodes other than the failed one, unless it is the only one
+func (network *_MirrorNetwork) _GetNextMirrorNodeAfter(failed *_MirrorNode) (*_MirrorNode, error) {
+	nodes := network._GetMirrorNodes()
+	if len(nodes) == 0 {
+		return nil, errors.New("no healthy nodes")
+	}
+
+	if len(nodes) > 1 && nodes[0] == failed {
+		return nodes[1], nil
+	}
+
+	return nodes[0], nil
+}
+
+// _GetMirrorNodes returns every mirror node in the order to fail over between them: the healthy nodes in random
+// order, followed by the nodes backed off after failures, the one readmitted first coming first. The nodes backed
+// off are still returned, as a mirror network is often a single node.
+func (network *_MirrorNetwork) _GetMirrorNodes() []*_MirrorNode {
+	network._ReadmitNodes()
+	network.healthyNodesMutex.RLock()
+	defer network.healthyNodesMutex.RUnlock()
+
+	healthy := make(map[_IManagedNode]bool, len(network.healthyNodes))
+	nodes := make([]*_MirrorNode, 0, len(network.nodes))
+	for _, node := range network.healthyNodes {
+		if mirrorNode, ok := node.(*_MirrorNode); ok {
+			healthy[node] = true
+			nodes = append(nodes, mirrorNode)
+		}
+	}
+	rand.Shuffle(len(nodes), func(i, j int) { nodes[i], nodes[j] = nodes[j], nodes[i] }) // nolint
+
+	backedOff := make([]*_MirrorNode, 0, len(network.nodes)-len(nodes))
+	for _, node := range network.nodes {
+		if mirrorNode, ok := node.(*_MirrorNode); ok && !healthy[node] {
+			backedOff = append(backedOff, mirrorNode)
+		}
 	}
-	return &_MirrorNode{}, nil
+	sort.SliceStable(backedOff, func(i, j int) bool {
+		return _ReadmitsBefore(backedOff[i]._GetReadmitTime(), backedOff[j]._GetReadmitTime())
+	})
+
+	return append(nodes, backedOff...)
+}
+
+func _ReadmitsBefore(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b != nil
+	}
+
+	return a.Before(*b)
+}
+
+// _GetNodeHealth returns the health of every mirror node, ordered by address
+func (network *_MirrorNetwork) _GetNodeHealth() []NodeHealth {
+	network.healthyNodesMutex.RLock()
+	nodes := make([]_IManagedNode, len(network.nodes))
+	copy(nodes, network.nodes)
+	network.healthyNodesMutex.RUnlock()
+
+	health := make([]NodeHealth, 0, len(nodes))
+	for _, node := range nodes {
+		if node, ok := node.(*_MirrorNode); ok {
+			health = append(health, node._ManagedNode._GetHealth())
+		}
+	}
+
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].Address < health[j].Address
+	})
+
+	return health
 }
@@ -0,0 +1,346 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnitClientSetMirrorNetworkEndpoints(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{})
+	defer client.Close()
+
+	require.NoError(t, client.SetMirrorNetworkEndpoints([]MirrorNodeEndpoint{
+		{GrpcAddress: "mirror-b:443", RestURL: "https://rest-b.example.com/api/v1/"},
+		{GrpcAddress: "mirror-a:443"},
+	}))
+	assert.Equal(t, []MirrorNodeEndpoint{
+		{GrpcAddress: "mirror-a:443"},
+		{GrpcAddress: "mirror-b:443", RestURL: "https://rest-b.example.com"},
+	}, client.GetMirrorNetworkEndpoints())
+	assert.ElementsMatch(t, []string{"mirror-a:443", "mirror-b:443"}, client.GetMirrorNetwork())
+
+	// A node kept from the previous network takes its new REST URL
+	node := client.mirrorNetwork.network["mirror-a:443"][0]
+	require.NoError(t, client.SetMirrorNetworkEndpoints([]MirrorNodeEndpoint{
+		{GrpcAddress: "mirror-a:443", RestURL: "https://rest-a.example.com"},
+	}))
+	assert.Same(t, node, client.mirrorNetwork.network["mirror-a:443"][0])
+	assert.Equal(t, []MirrorNodeEndpoint{{GrpcAddress: "mirror-a:443", RestURL: "https://rest-a.example.com"}}, client.GetMirrorNetworkEndpoints())
+
+	client.SetMirrorNetwork([]string{"mirror-a:443"})
+	assert.Equal(t, []MirrorNodeEndpoint{{GrpcAddress: "mirror-a:443"}}, client.GetMirrorNetworkEndpoints())
+
+	require.Error(t, client.SetMirrorNetworkEndpoints([]MirrorNodeEndpoint{{GrpcAddress: "no-port"}}))
+}
+
+func TestUnitMirrorNetworkOrdersBackedOffNodesLast(t *testing.T) {
+	t.Parallel()
+
+	network := _NewMirrorNetwork()
+	require.NoError(t, network._SetNetwork([]string{"mirror-a:443", "mirror-b:443", "mirror-c:443"}))
+
+	failed := network.network["mirror-b:443"][0].(*_MirrorNode)
+	network._IncreaseBackoff(failed)
+	assert.Len(t, network.healthyNodes, 2)
+
+	for i := 0; i < 10; i++ {
+		nodes := network._GetMirrorNodes()
+		require.Len(t, nodes, 3)
+		assert.Same(t, failed, nodes[2])
+
+		next, err := network._GetNextMirrorNode()
+		require.NoError(t, err)
+		assert.NotSame(t, failed, next)
+
+		// Failing over from a node moves to another one
+		after, err := network._GetNextMirrorNodeAfter(next)
+		require.NoError(t, err)
+		assert.NotSame(t, next, after)
+		assert.NotSame(t, failed, after)
+	}
+
+	health := network._GetNodeHealth()
+	require.Len(t, health, 3)
+	assert.Equal(t, "mirror-b:443", health[1].Address)
+	assert.NotNil(t, health[1].ReadmitTime)
+	assert.Equal(t, int64(1), health[1].ErrorCount)
+	assert.True(t, health[0].Healthy)
+	assert.True(t, health[2].Healthy)
+	assert.Zero(t, health[0].AccountID)
+
+	// Backed off nodes are still returned when none is healthy, the one readmitted first coming first
+	network._IncreaseBackoff(network.network["mirror-a:443"][0])
+	network._IncreaseBackoff(network.network["mirror-c:443"][0])
+	nodes := network._GetMirrorNodes()
+	require.Len(t, nodes, 3)
+	assert.Same(t, failed, nodes[0])
+
+	require.NoError(t, network._SetNetwork([]string{}))
+	_, err := network._GetNextMirrorNode()
+	require.Error(t, err)
+}
+
+func TestUnitMirrorNodeContractQueriesFailOverAcrossMirrorNodes(t *testing.T) {
+	t.Parallel()
+
+	var failingCalls, workingCalls int32
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&failingCalls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	working := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&workingCalls, 1)
+		require.Equal(t, "/api/v1/contracts/call", r.URL.Path)
+		_, _ = w.Write([]byte(`{"result":"0x5208"}`))
+	}))
+	defer working.Close()
+
+	client := ClientForNetwork(map[string]AccountID{})
+	defer client.Close()
+	require.NoError(t, client.SetMirrorNetworkEndpoints([]MirrorNodeEndpoint{
+		{GrpcAddress: "127.0.0.1:5600", RestURL: failing.URL},
+		{GrpcAddress: "127.0.0.2:5600", RestURL: working.URL},
+	}))
+	client.GetMirrorNodeClient().SetMinBackoff(time.Millisecond).SetMaxBackoff(10 * time.Millisecond)
+
+	// Backing off the working node makes the failing one the first tried
+	workingNode := client.mirrorNetwork.network["127.0.0.2:5600"][0]
+	client.mirrorNetwork._IncreaseBackoff(workingNode)
+
+	result, err := NewMirrorNodeContractCallQuery().
+		SetContractID(ContractID{Contract: 1234}).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "0x5208", result)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&failingCalls))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&workingCalls))
+
+	health := client.GetMirrorNodeHealth()
+	require.Len(t, health, 2)
+	assert.NotNil(t, health[0].ReadmitTime)
+	assert.Equal(t, int64(1), health[0].ErrorCount)
+	assert.Equal(t, int64(1), health[1].UseCount)
+	assert.Greater(t, health[1].AverageLatency, time.Duration(0))
+
+	// The failing node is now backed off, so the working one is tried first
+	gas, err := NewMirrorNodeContractEstimateGasQuery().
+		SetContractID(ContractID{Contract: 1234}).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x5208), gas)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&failingCalls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&workingCalls))
+}
+
+func TestUnitTopicMessageQueryBacksOffFailedMirrorNode(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		if call == 0 {
+			return status.Error(codes.Unavailable, "mirror node is restarting")
+		}
+		return stream.Send(_TopicResponse(1, nil))
+	}}
+	client := _NewScriptedTopicClient(t, script, 2)
+
+	completed := make(chan struct{})
+	_, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetLimit(1).
+		SetCompletionHandler(func() { close(completed) }).
+		SetErrorHandler(func(stat status.Status) { t.Errorf("unexpected error %v", stat.Code()) }).
+		Subscribe(client, func(message TopicMessage) {})
+	require.NoError(t, err)
+
+	select {
+	case <-completed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	// The subscription resumed on the other mirror node after the first one failed
+	health := client.GetMirrorNodeHealth()
+	require.Len(t, health, 2)
+	errorCounts := []int64{health[0].ErrorCount, health[1].ErrorCount}
+	assert.ElementsMatch(t, []int64{0, 1}, errorCounts)
+	for _, nodeHealth := range health {
+		assert.Equal(t, int64(1), nodeHealth.UseCount)
+		assert.Equal(t, nodeHealth.ErrorCount == 0, nodeHealth.ReadmitTime == nil)
+		assert.Zero(t, nodeHealth.InFlight)
+	}
+}
+
+func TestUnitTopicMessageQueryDoesNotBackOffMirrorNodeForMissingTopic(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		if call == 0 {
+			return status.Error(codes.NotFound, "topic not found")
+		}
+		return stream.Send(_TopicResponse(1, nil))
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	completed := make(chan struct{})
+	_, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetLimit(1).
+		SetCompletionHandler(func() { close(completed) }).
+		SetErrorHandler(func(stat status.Status) { t.Errorf("unexpected error %v", stat.Code()) }).
+		Subscribe(client, func(message TopicMessage) {})
+	require.NoError(t, err)
+
+	select {
+	case <-completed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	// The topic not being found yet is retried, but is no failure of the mirror node
+	health := client.GetMirrorNodeHealth()
+	require.Len(t, health, 1)
+	assert.Zero(t, health[0].ErrorCount)
+	assert.Nil(t, health[0].ReadmitTime)
+}
+
+func TestUnitIsMirrorNodeFailure(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, _IsMirrorNodeFailure(status.Error(codes.Unavailable, "")))
+	assert.True(t, _IsMirrorNodeFailure(status.Error(codes.ResourceExhausted, "")))
+	assert.True(t, _IsMirrorNodeFailure(status.Error(codes.Internal, "stream terminated by RST_STREAM with error code: INTERNAL_ERROR")))
+	assert.False(t, _IsMirrorNodeFailure(status.Error(codes.Internal, "internal error")))
+	assert.False(t, _IsMirrorNodeFailure(status.Error(codes.NotFound, "")))
+	assert.False(t, _IsMirrorNodeFailure(errors.New("not a gRPC error")))
+}
+
+func TestUnitMirrorNodeClientBaseURLIsStable(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{})
+	defer client.Close()
+	require.NoError(t, client.SetMirrorNetworkEndpoints([]MirrorNodeEndpoint{
+		{GrpcAddress: "mirror-c:443", RestURL: "https://rest-c.example.com"},
+		{GrpcAddress: "mirror-a:443", RestURL: "https://rest-a.example.com"},
+		{GrpcAddress: "mirror-b:443", RestURL: "https://rest-b.example.com"},
+	}))
+
+	for i := 0; i < 10; i++ {
+		baseURL, err := client.GetMirrorNodeClient().GetBaseURL()
+		require.NoError(t, err)
+		assert.Equal(t, "https://rest-a.example.com", baseURL)
+
+		contractCallBaseURL, err := client.GetMirrorNodeClient().GetContractCallBaseURL()
+		require.NoError(t, err)
+		assert.Equal(t, "https://rest-a.example.com", contractCallBaseURL)
+	}
+}
+
+func TestUnitMirrorNetworkEndpointsChangeWhileInUse(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{})
+	defer client.Close()
+	require.NoError(t, client.SetMirrorNetworkEndpoints([]MirrorNodeEndpoint{{GrpcAddress: "mirror-a:443"}}))
+
+	// Run with -race: the REST URL of a kept node changes while requests read it
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_, _ = client.GetMirrorNodeClient().GetBaseURL()
+			_ = client.GetMirrorNetworkEndpoints()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, client.SetMirrorNetworkEndpoints([]MirrorNodeEndpoint{
+			{GrpcAddress: "mirror-a:443", RestURL: "https://rest-a.example.com/" + string(rune('a'+i%26))},
+		}))
+	}
+	<-done
+}
+
+// _ScriptedNetworkServer fails the first address book subscription across all the servers sharing the calls
+type _ScriptedNetworkServer struct {
+	mirror.UnimplementedNetworkServiceServer
+	mu    *sync.Mutex
+	calls *int
+}
+
+func (server *_ScriptedNetworkServer) GetNodes(_ *mirror.AddressBookQuery, stream mirror.NetworkService_GetNodesServer) error {
+	server.mu.Lock()
+	call := *server.calls
+	*server.calls++
+	server.mu.Unlock()
+
+	if call == 0 {
+		if err := stream.Send(&services.NodeAddress{NodeAccountId: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 3}}}); err != nil {
+			return err
+		}
+		return status.Error(codes.Unavailable, "mirror node is restarting")
+	}
+
+	return stream.Send(&services.NodeAddress{NodeAccountId: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 3}}})
+}
+
+func TestUnitAddressBookQueryFailsOverToNextMirrorNode(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var calls int
+	addresses := make([]string, 0, 2)
+	for i := 0; i < 2; i++ {
+		listener, err := net.Listen("tcp", "localhost:0")
+		require.NoError(t, err)
+		grpcServer := grpc.NewServer()
+		mirror.RegisterNetworkServiceServer(grpcServer, &_ScriptedNetworkServer{mu: &mu, calls: &calls})
+		go func() {
+			_ = grpcServer.Serve(listener)
+		}()
+		t.Cleanup(grpcServer.Stop)
+
+		addresses = append(addresses, listener.Addr().String())
+	}
+
+	client := ClientForNetwork(map[string]AccountID{})
+	defer client.Close()
+	client.SetMirrorNetwork(addresses)
+
+	addressBook, err := NewAddressBookQuery().
+		SetFileID(FileID{File: 102}).
+		SetMaxAttempts(2).
+		Execute(client)
+	require.NoError(t, err)
+
+	// The address book is read again from its start, so the node sent before the failure is not duplicated
+	require.Len(t, addressBook.NodeAddresses, 1)
+	mu.Lock()
+	assert.Equal(t, 2, calls)
+	mu.Unlock()
+
+	health := client.GetMirrorNodeHealth()
+	require.Len(t, health, 2)
+	assert.ElementsMatch(t, []int64{0, 1}, []int64{health[0].ErrorCount, health[1].ErrorCount})
+}
@@ -4,6 +4,8 @@ package hiero
 
 import (
 	"crypto/tls"
+	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/credentials/insecure"
@@ -21,6 +23,8 @@ type _MirrorNode struct {
 	consensusServiceClient *mirror.ConsensusServiceClient
 	networkServiceClient   *mirror.NetworkServiceClient
 	client                 *grpc.ClientConn
+	// restURL is the base URL of the REST API of the mirror node, derived from its gRPC address when empty
+	restURL string
 }
 
 func (node *_MirrorNode) _SetVerifyCertificate(_ bool) {
@@ -36,6 +40,27 @@ func _NewMirrorNode(address string) (node *_MirrorNode, err error) {
 	return node, err
 }
 
+// _GetRestURL returns the base URL of the REST API of the mirror node. Unless it was set, it is the host of the
+// gRPC address over HTTPS, or over HTTP on the local port for a network without a ledger ID.
+func (node *_MirrorNode) _GetRestURL(ledgerID *LedgerID, localPort string) (string, error) {
+	if node.restURL != "" {
+		return node.restURL, nil
+	}
+
+	address := node._GetAddress()
+	index := strings.Index(address, ":")
+	if index == -1 {
+		return "", errors.New("invalid mirrorUrl format")
+	}
+	host := address[:index]
+
+	if ledgerID == nil {
+		return fmt.Sprintf("http://%s:%s", host, localPort), nil
+	}
+
+	return fmt.Sprintf("https://%s", host), nil
+}
+
 func (node *_MirrorNode) _GetKey() string {
 	return node.address._String()
 }
@@ -123,7 +148,7 @@ func (node *_MirrorNode) _GetConsensusServiceClient() (*mirror.ConsensusServiceC
 		security = grpc.WithTransportCredentials(insecure.NewCredentials())
 	}
 
-	conn, err := grpc.NewClient(node._ManagedNode.address._String(), security, grpc.WithKeepaliveParams(kacp))
+	conn, err := grpc.NewClient(node._ManagedNode.address._String(), node.grpcOptions._Apply(security, grpc.WithKeepaliveParams(kacp))...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error connecting to mirror at %s", node._ManagedNode.address._String())
 	}
@@ -158,7 +183,7 @@ func (node *_MirrorNode) _GetNetworkServiceClient() (*mirror.NetworkServiceClien
 		security = grpc.WithTransportCredentials(insecure.NewCredentials())
 	}
 
-	conn, err := grpc.NewClient(node._ManagedNode.address._String(), security, grpc.WithKeepaliveParams(kacp))
+	conn, err := grpc.NewClient(node._ManagedNode.address._String(), node.grpcOptions._Apply(security, grpc.WithKeepaliveParams(kacp))...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error connecting to mirror at %s", node._ManagedNode.address._String())
 	}
@@ -179,12 +204,14 @@ func (node *_MirrorNode) _ToSecure() _IManagedNode {
 		useCount:           node.useCount,
 		minBackoff:         node.minBackoff,
 		badGrpcStatusCount: node.badGrpcStatusCount,
+		grpcOptions:        node.grpcOptions,
 	}
 
 	return &_MirrorNode{
 		_ManagedNode:           &managed,
 		consensusServiceClient: node.consensusServiceClient,
 		client:                 node.client,
+		restURL:                node.restURL,
 	}
 }
 
@@ -197,12 +224,14 @@ func (node *_MirrorNode) _ToInsecure() _IManagedNode {
 		useCount:           node.useCount,
 		minBackoff:         node.minBackoff,
 		badGrpcStatusCount: node.badGrpcStatusCount,
+		grpcOptions:        node.grpcOptions,
 	}
 
 	return &_MirrorNode{
 		_ManagedNode:           &managed,
 		consensusServiceClient: node.consensusServiceClient,
 		client:                 node.client,
+		restURL:                node.restURL,
 	}
 }
 
@@ -0,0 +1,634 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const mirrorNodeAPIPrefix = "/api/v1"
+
+// MirrorNodeOrder is the sort order of a paginated mirror node REST request
+type MirrorNodeOrder string
+
+const (
+	MirrorNodeOrderAsc  MirrorNodeOrder = "asc"
+	MirrorNodeOrderDesc MirrorNodeOrder = "desc"
+)
+
+// MirrorNodeClient is a typed client for the mirror node REST API. It is obtained from
+// Client.GetMirrorNodeClient and by default targets the first mirror node configured on the Client.
+type MirrorNodeClient struct {
+	client              *Client
+	httpClient          *http.Client
+	baseURL             *string
+	contractCallBaseURL *string
+	maxAttempts         int
+	minBackoff          time.Duration
+	maxBackoff          time.Duration
+}
+
+// MirrorNodeQueryParams holds the paging and filter parameters of a mirror node REST list request.
+type MirrorNodeQueryParams struct {
+	limit   int
+	order   MirrorNodeOrder
+	filters url.Values
+}
+
+// MirrorNodePage is a single page of results returned by a paginated mirror node REST request.
+type MirrorNodePage[T any] struct {
+	Items []T
+
+	mirrorNodeClient *MirrorNodeClient
+	key              string
+	next             string
+}
+
+type _MirrorNodeLinks struct {
+	Next *string `json:"next"`
+}
+
+func _NewMirrorNodeClient(client *Client) *MirrorNodeClient {
+	return &MirrorNodeClient{
+		client:      client,
+		httpClient:  http.DefaultClient,
+		maxAttempts: 5,
+		minBackoff:  250 * time.Millisecond,
+		maxBackoff:  8 * time.Second,
+	}
+}
+
+// NewMirrorNodeQueryParams creates an empty set of paging and filter parameters.
+func NewMirrorNodeQueryParams() *MirrorNodeQueryParams {
+	return &MirrorNodeQueryParams{
+		filters: url.Values{},
+	}
+}
+
+// SetLimit sets the maximum number of items returned per page.
+func (params *MirrorNodeQueryParams) SetLimit(limit int) *MirrorNodeQueryParams {
+	params.limit = limit
+	return params
+}
+
+// GetLimit returns the maximum number of items returned per page.
+func (params *MirrorNodeQueryParams) GetLimit() int {
+	return params.limit
+}
+
+// SetOrder sets the sort order of the results.
+func (params *MirrorNodeQueryParams) SetOrder(order MirrorNodeOrder) *MirrorNodeQueryParams {
+	params.order = order
+	return params
+}
+
+// GetOrder returns the sort order of the results.
+func (params *MirrorNodeQueryParams) GetOrder() MirrorNodeOrder {
+	return params.order
+}
+
+// AddFilter adds a mirror node query filter, e.g. AddFilter("timestamp", "gte:1700000000.000000000")
+// or AddFilter("account.id", "0.0.1234"). The same name can be added more than once.
+func (params *MirrorNodeQueryParams) AddFilter(name string, value string) *MirrorNodeQueryParams {
+	params.filters.Add(name, value)
+	return params
+}
+
+func (params *MirrorNodeQueryParams) _Encode() string {
+	if params == nil {
+		return ""
+	}
+
+	values := url.Values{}
+	for name, filters := range params.filters {
+		values[name] = append([]string{}, filters...)
+	}
+	if params.limit > 0 {
+		values.Set("limit", strconv.Itoa(params.limit))
+	}
+	if params.order != "" {
+		values.Set("order", string(params.order))
+	}
+
+	return values.Encode()
+}
+
+// HasNext returns whether the mirror node reported another page of results.
+func (page *MirrorNodePage[T]) HasNext() bool {
+	return page.next != ""
+}
+
+// Next fetches the following page of results. It returns an error if there is no next page.
+func (page *MirrorNodePage[T]) Next(ctx context.Context) (*MirrorNodePage[T], error) {
+	if !page.HasNext() {
+		return nil, errors.New("no next page")
+	}
+
+	return _MirrorNodeGetPage[T](ctx, page.mirrorNodeClient, page.next, page.key)
+}
+
+// SetBaseURL sets the scheme, host and optional port of the mirror node REST API, e.g. "http://localhost:5551".
+// When unset the requests go to the REST URLs of the mirror nodes configured on the Client, failing over from one
+// to the next.
+func (m *MirrorNodeClient) SetBaseURL(baseURL string) *MirrorNodeClient {
+	baseURL = _TrimMirrorNodeBaseURL(baseURL)
+	m.baseURL = &baseURL
+	return m
+}
+
+// GetBaseURL returns the base URL used for mirror node REST requests. With several mirror nodes, requests fail over
+// between them and this is the base URL of the first one by gRPC address.
+func (m *MirrorNodeClient) GetBaseURL() (string, error) {
+	targets, err := m._Targets(false)
+	if err != nil {
+		return "", err
+	}
+
+	return _FirstTargetByAddress(targets).baseURL, nil
+}
+
+// SetContractCallBaseURL sets the base URL used for /contracts/call requests. Local networks serve these from a
+// separate web3 module; when unset it defaults to the base URL, or port 8545 for local networks.
+func (m *MirrorNodeClient) SetContractCallBaseURL(baseURL string) *MirrorNodeClient {
+	baseURL = _TrimMirrorNodeBaseURL(baseURL)
+	m.contractCallBaseURL = &baseURL
+	return m
+}
+
+// GetContractCallBaseURL returns the base URL used for /contracts/call requests. With several mirror nodes, requests
+// fail over between them and this is the base URL of the first one by gRPC address.
+func (m *MirrorNodeClient) GetContractCallBaseURL() (string, error) {
+	targets, err := m._Targets(true)
+	if err != nil {
+		return "", err
+	}
+
+	return _FirstTargetByAddress(targets).baseURL, nil
+}
+
+// SetHTTPClient sets the HTTP client used for mirror node REST requests.
+func (m *MirrorNodeClient) SetHTTPClient(httpClient *http.Client) *MirrorNodeClient {
+	m.httpClient = httpClient
+	return m
+}
+
+// GetHTTPClient returns the HTTP client used for mirror node REST requests.
+func (m *MirrorNodeClient) GetHTTPClient() *http.Client {
+	return m.httpClient
+}
+
+// SetMaxAttempts sets the maximum number of attempts for a single request.
+func (m *MirrorNodeClient) SetMaxAttempts(maxAttempts int) *MirrorNodeClient {
+	m.maxAttempts = maxAttempts
+	return m
+}
+
+// GetMaxAttempts returns the maximum number of attempts for a single request.
+func (m *MirrorNodeClient) GetMaxAttempts() int {
+	return m.maxAttempts
+}
+
+// SetMinBackoff sets the minimum amount of time to wait between retries.
+func (m *MirrorNodeClient) SetMinBackoff(min time.Duration) *MirrorNodeClient {
+	if min.Nanoseconds() < 0 {
+		panic("minBackoff must be a positive duration")
+	} else if m.maxBackoff.Nanoseconds() < min.Nanoseconds() {
+		panic("minBackoff must be less than or equal to maxBackoff")
+	}
+	m.minBackoff = min
+	return m
+}
+
+// GetMinBackoff returns the minimum amount of time to wait between retries.
+func (m *MirrorNodeClient) GetMinBackoff() time.Duration {
+	return m.minBackoff
+}
+
+// SetMaxBackoff sets the maximum amount of time to wait between retries.
+func (m *MirrorNodeClient) SetMaxBackoff(max time.Duration) *MirrorNodeClient {
+	if max.Nanoseconds() < 0 {
+		panic("maxBackoff must be a positive duration")
+	} else if max.Nanoseconds() < m.minBackoff.Nanoseconds() {
+		panic("maxBackoff must be greater than or equal to minBackoff")
+	}
+	m.maxBackoff = max
+	return m
+}
+
+// GetMaxBackoff returns the maximum amount of time to wait between retries.
+func (m *MirrorNodeClient) GetMaxBackoff() time.Duration {
+	return m.maxBackoff
+}
+
+// GetAccount returns the account with the given account ID, alias or EVM address.
+func (m *MirrorNodeClient) GetAccount(ctx context.Context, idOrAliasOrEvmAddress string) (MirrorNodeAccount, error) {
+	var account MirrorNodeAccount
+	err := m._Get(ctx, "/accounts/"+url.PathEscape(idOrAliasOrEvmAddress), nil, &account)
+	return account, err
+}
+
+// GetAccounts returns the first page of accounts matching the parameters.
+func (m *MirrorNodeClient) GetAccounts(ctx context.Context, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeAccount], error) {
+	return _MirrorNodeGetPage[MirrorNodeAccount](ctx, m, _MirrorNodePath("/accounts", params), "accounts")
+}
+
+// GetBalances returns the first page of account balances matching the parameters, e.g. filtered by "account.id".
+func (m *MirrorNodeClient) GetBalances(ctx context.Context, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeBalance], error) {
+	return _MirrorNodeGetPage[MirrorNodeBalance](ctx, m, _MirrorNodePath("/balances", params), "balances")
+}
+
+// GetToken returns the token with the given ID.
+func (m *MirrorNodeClient) GetToken(ctx context.Context, tokenID TokenID) (MirrorNodeTokenInfo, error) {
+	var token MirrorNodeTokenInfo
+	err := m._Get(ctx, "/tokens/"+tokenID.String(), nil, &token)
+	return token, err
+}
+
+// GetTokens returns the first page of tokens matching the parameters.
+func (m *MirrorNodeClient) GetTokens(ctx context.Context, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeToken], error) {
+	return _MirrorNodeGetPage[MirrorNodeToken](ctx, m, _MirrorNodePath("/tokens", params), "tokens")
+}
+
+// GetNft returns the NFT with the given ID.
+func (m *MirrorNodeClient) GetNft(ctx context.Context, nftID NftID) (MirrorNodeNft, error) {
+	var nft MirrorNodeNft
+	err := m._Get(ctx, fmt.Sprintf("/tokens/%s/nfts/%d", nftID.TokenID.String(), nftID.SerialNumber), nil, &nft)
+	return nft, err
+}
+
+// GetTokenNfts returns the first page of NFTs of the given token.
+func (m *MirrorNodeClient) GetTokenNfts(ctx context.Context, tokenID TokenID, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeNft], error) {
+	return _MirrorNodeGetPage[MirrorNodeNft](ctx, m, _MirrorNodePath("/tokens/"+tokenID.String()+"/nfts", params), "nfts")
+}
+
+// GetAccountNfts returns the first page of NFTs owned by the account with the given account ID, alias or EVM address.
+func (m *MirrorNodeClient) GetAccountNfts(ctx context.Context, idOrAliasOrEvmAddress string, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeNft], error) {
+	return _MirrorNodeGetPage[MirrorNodeNft](ctx, m, _MirrorNodePath("/accounts/"+url.PathEscape(idOrAliasOrEvmAddress)+"/nfts", params), "nfts")
+}
+
+// GetTransaction returns the transaction with the given ID together with its child and duplicate transactions.
+func (m *MirrorNodeClient) GetTransaction(ctx context.Context, transactionID TransactionID) ([]MirrorNodeTransaction, error) {
+	var result struct {
+		Transactions []MirrorNodeTransaction `json:"transactions"`
+	}
+	err := m._Get(ctx, "/transactions/"+_TransactionIDToMirrorNodeString(transactionID), nil, &result)
+	return result.Transactions, err
+}
+
+// GetTransactions returns the first page of transactions matching the parameters.
+func (m *MirrorNodeClient) GetTransactions(ctx context.Context, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeTransaction], error) {
+	return _MirrorNodeGetPage[MirrorNodeTransaction](ctx, m, _MirrorNodePath("/transactions", params), "transactions")
+}
+
+// GetContract returns the contract with the given contract ID or EVM address.
+func (m *MirrorNodeClient) GetContract(ctx context.Context, idOrEvmAddress string) (MirrorNodeContract, error) {
+	var contract MirrorNodeContract
+	err := m._Get(ctx, "/contracts/"+url.PathEscape(idOrEvmAddress), nil, &contract)
+	return contract, err
+}
+
+// GetContractResult returns the contract result of the transaction with the given transaction ID or Ethereum hash.
+func (m *MirrorNodeClient) GetContractResult(ctx context.Context, transactionIDOrHash string) (MirrorNodeContractResult, error) {
+	var result MirrorNodeContractResult
+	err := m._Get(ctx, "/contracts/results/"+url.PathEscape(transactionIDOrHash), nil, &result)
+	return result, err
+}
+
+// GetContractResults returns the first page of results of the contract with the given contract ID or EVM address.
+func (m *MirrorNodeClient) GetContractResults(ctx context.Context, idOrEvmAddress string, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeContractResult], error) {
+	return _MirrorNodeGetPage[MirrorNodeContractResult](ctx, m, _MirrorNodePath("/contracts/"+url.PathEscape(idOrEvmAddress)+"/results", params), "results")
+}
+
+// GetContractLogs returns the first page of logs emitted by the contract with the given contract ID or EVM address.
+func (m *MirrorNodeClient) GetContractLogs(ctx context.Context, idOrEvmAddress string, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeContractLog], error) {
+	return _MirrorNodeGetPage[MirrorNodeContractLog](ctx, m, _MirrorNodePath("/contracts/"+url.PathEscape(idOrEvmAddress)+"/results/logs", params), "logs")
+}
+
+// GetTopicMessage returns the message with the given sequence number of a topic.
+func (m *MirrorNodeClient) GetTopicMessage(ctx context.Context, topicID TopicID, sequenceNumber uint64) (MirrorNodeTopicMessage, error) {
+	var message MirrorNodeTopicMessage
+	err := m._Get(ctx, fmt.Sprintf("/topics/%s/messages/%d", topicID.String(), sequenceNumber), nil, &message)
+	return message, err
+}
+
+// GetTopicMessages returns the first page of messages of a topic.
+func (m *MirrorNodeClient) GetTopicMessages(ctx context.Context, topicID TopicID, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeTopicMessage], error) {
+	return _MirrorNodeGetPage[MirrorNodeTopicMessage](ctx, m, _MirrorNodePath("/topics/"+topicID.String()+"/messages", params), "messages")
+}
+
+// GetSchedule returns the schedule with the given ID.
+func (m *MirrorNodeClient) GetSchedule(ctx context.Context, scheduleID ScheduleID) (MirrorNodeSchedule, error) {
+	var schedule MirrorNodeSchedule
+	err := m._Get(ctx, "/schedules/"+scheduleID.String(), nil, &schedule)
+	return schedule, err
+}
+
+// GetSchedules returns the first page of schedules matching the parameters.
+func (m *MirrorNodeClient) GetSchedules(ctx context.Context, params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeSchedule], error) {
+	return _MirrorNodeGetPage[MirrorNodeSchedule](ctx, m, _MirrorNodePath("/schedules", params), "schedules")
+}
+
+// _ContractCall posts a payload to /contracts/call and returns the "result" field of the response
+func (m *MirrorNodeClient) _ContractCall(ctx context.Context, payload []byte) (string, error) {
+	targets, err := m._Targets(true)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		Result *string `json:"result"`
+	}
+	if err := m._Do(ctx, http.MethodPost, targets, mirrorNodeAPIPrefix+"/contracts/call", payload, &result); err != nil {
+		return "", err
+	}
+	if result.Result == nil {
+		return "", errors.New("result is not a string")
+	}
+
+	return *result.Result, nil
+}
+
+// _MirrorNodeRestTarget is a base URL a REST request can be sent to, with the mirror node serving it unless the URL
+// was set on the MirrorNodeClient
+type _MirrorNodeRestTarget struct {
+	baseURL string
+	node    *_MirrorNode
+}
+
+// _Targets returns the base URLs to fail over between, the one set on the MirrorNodeClient or else those of the
+// mirror nodes of the Client in the order of the mirror network. Contract calls go to port 8545 rather than 5551
+// on local networks, where they are served by a separate web3 module.
+func (m *MirrorNodeClient) _Targets(contractCall bool) ([]_MirrorNodeRestTarget, error) {
+	if contractCall && m.contractCallBaseURL != nil {
+		return []_MirrorNodeRestTarget{{baseURL: *m.contractCallBaseURL}}, nil
+	}
+	if m.baseURL != nil {
+		return []_MirrorNodeRestTarget{{baseURL: *m.baseURL}}, nil
+	}
+
+	if m.client == nil || m.client.mirrorNetwork == nil {
+		return nil, errors.New("mirror node is not set")
+	}
+	nodes := m.client.mirrorNetwork._GetMirrorNodes()
+	if len(nodes) == 0 {
+		return nil, errors.New("mirror node is not set")
+	}
+
+	localPort := "5551"
+	if contractCall {
+		localPort = "8545"
+	}
+
+	targets := make([]_MirrorNodeRestTarget, 0, len(nodes))
+	for _, node := range nodes {
+		baseURL, err := m.client.mirrorNetwork._GetRestURL(node, m.client.GetLedgerID(), localPort)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, _MirrorNodeRestTarget{baseURL: baseURL, node: node})
+	}
+
+	return targets, nil
+}
+
+// _FirstTargetByAddress returns the target of the mirror node first by gRPC address, so the base URL reported does
+// not change with the order the requests are tried in
+func _FirstTargetByAddress(targets []_MirrorNodeRestTarget) _MirrorNodeRestTarget {
+	first := targets[0]
+	for _, target := range targets[1:] {
+		if target.node != nil && first.node != nil && target.node._GetAddress() < first.node._GetAddress() {
+			first = target
+		}
+	}
+
+	return first
+}
+
+// _Get fetches a path relative to the API prefix, or a "next" link which already carries the prefix
+func (m *MirrorNodeClient) _Get(ctx context.Context, path string, params *MirrorNodeQueryParams, out interface{}) error {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return m._Do(ctx, http.MethodGet, []_MirrorNodeRestTarget{{}}, path, nil, out)
+	}
+
+	targets, err := m._Targets(false)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(path, mirrorNodeAPIPrefix) {
+		path = mirrorNodeAPIPrefix + _MirrorNodePath(path, params)
+	}
+
+	return m._Do(ctx, http.MethodGet, targets, path, nil, out)
+}
+
+// _Do sends the request to the targets in turn, moving to the next one after a failure worth retrying. The wait
+// between attempts only starts once every target was tried. The mirror nodes of the targets are backed off after
+// such failures, like consensus nodes after failed gRPC calls.
+func (m *MirrorNodeClient) _Do(ctx context.Context, method string, targets []_MirrorNodeRestTarget, path string, body []byte, out interface{}) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	maxAttempts := m.maxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	backoff := m.minBackoff
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt >= len(targets) {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ErrExecutionInterrupted{Attempt: int64(attempt + 1), MaxAttempts: maxAttempts, Cause: ctx.Err(), LastError: lastErr}
+			case <-timer.C:
+			}
+			if backoff *= 2; backoff > m.maxBackoff {
+				backoff = m.maxBackoff
+			}
+		}
+
+		target := targets[attempt%len(targets)]
+		if target.node != nil {
+			target.node._InUse()
+			target.node._StartRequest()
+		}
+
+		start := time.Now()
+		retry, err := m._DoOnce(ctx, method, target.baseURL+path, body, out)
+
+		if target.node != nil {
+			target.node._EndRequest()
+			if err == nil {
+				target.node._RecordLatency(time.Since(start))
+				target.node._DecreaseBackoff()
+			} else if retry && ctx.Err() == nil {
+				m.client.mirrorNetwork._IncreaseBackoff(target.node)
+			}
+		}
+
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if ctx.Err() != nil {
+			return ErrExecutionInterrupted{Attempt: int64(attempt + 1), MaxAttempts: maxAttempts, Cause: ctx.Err(), LastError: lastErr}
+		}
+		if !retry {
+			return err
+		}
+	}
+
+	return lastErr
+}
+
+// _DoOnce performs a single request and reports whether a failure is worth retrying
+func (m *MirrorNodeClient) _DoOnce(ctx context.Context, method string, requestURL string, body []byte, out interface{}) (bool, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := m.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(request)
+	if err != nil {
+		return true, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		details, _ := io.ReadAll(resp.Body)
+		return _MirrorNodeIsRetryableStatus(resp.StatusCode), ErrMirrorNodeStatus{
+			StatusCode: resp.StatusCode,
+			URL:        requestURL,
+			Details:    string(details),
+		}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, errors.Wrap(err, "failed to decode mirror node response")
+	}
+
+	return false, nil
+}
+
+func _MirrorNodeIsRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func _MirrorNodeGetPage[T any](ctx context.Context, m *MirrorNodeClient, path string, key string) (*MirrorNodePage[T], error) {
+	var raw map[string]json.RawMessage
+	if err := m._Get(ctx, path, nil, &raw); err != nil {
+		return nil, err
+	}
+
+	page := MirrorNodePage[T]{
+		Items:            make([]T, 0),
+		mirrorNodeClient: m,
+		key:              key,
+	}
+
+	if items, ok := raw[key]; ok {
+		if err := json.Unmarshal(items, &page.Items); err != nil {
+			return nil, errors.Wrap(err, "failed to decode mirror node response")
+		}
+	}
+
+	if linksRaw, ok := raw["links"]; ok {
+		var links _MirrorNodeLinks
+		if err := json.Unmarshal(linksRaw, &links); err != nil {
+			return nil, errors.Wrap(err, "failed to decode mirror node response")
+		}
+		if links.Next != nil {
+			page.next = *links.Next
+		}
+	}
+
+	return &page, nil
+}
+
+// _TrimMirrorNodeBaseURL removes a trailing slash and API prefix from a mirror node REST URL
+func _TrimMirrorNodeBaseURL(baseURL string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(baseURL, "/"), mirrorNodeAPIPrefix)
+}
+
+func _MirrorNodePath(path string, params *MirrorNodeQueryParams) string {
+	if query := params._Encode(); query != "" {
+		return path + "?" + query
+	}
+
+	return path
+}
+
+// _TransactionIDToMirrorNodeString formats a transaction ID as the mirror node expects it, e.g. 0.0.2-1700000000-000000001
+func _TransactionIDToMirrorNodeString(transactionID TransactionID) string {
+	var accountID string
+	if transactionID.AccountID != nil {
+		accountID = transactionID.AccountID.String()
+	}
+
+	var seconds, nanos int64
+	if transactionID.ValidStart != nil {
+		seconds = transactionID.ValidStart.Unix()
+		nanos = int64(transactionID.ValidStart.Nanosecond())
+	}
+
+	return fmt.Sprintf("%s-%d-%09d", accountID, seconds, nanos)
+}
+
+// ParseMirrorNodeTimestamp parses a mirror node timestamp of the form "seconds.nanoseconds".
+func ParseMirrorNodeTimestamp(timestamp string) (time.Time, error) {
+	parts := strings.SplitN(timestamp, ".", 2)
+
+	seconds, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid mirror node timestamp %q", timestamp)
+	}
+
+	var nanos int64
+	if len(parts) == 2 && parts[1] != "" {
+		fraction := parts[1]
+		if len(fraction) > 9 {
+			return time.Time{}, fmt.Errorf("invalid mirror node timestamp %q", timestamp)
+		}
+		fraction += strings.Repeat("0", 9-len(fraction))
+		if nanos, err = strconv.ParseInt(fraction, 10, 64); err != nil {
+			return time.Time{}, errors.Wrapf(err, "invalid mirror node timestamp %q", timestamp)
+		}
+	}
+
+	return time.Unix(seconds, nanos), nil
+}
@@ -0,0 +1,249 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func _NewMirrorNodeTestServer(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.GetMirrorNodeClient().
+		SetBaseURL(server.URL).
+		SetMinBackoff(time.Millisecond).
+		SetMaxBackoff(10 * time.Millisecond)
+
+	return client, server
+}
+
+func TestUnitMirrorNodeClientDefaultBaseURL(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+
+	baseURL, err := client.GetMirrorNodeClient().GetBaseURL()
+	require.NoError(t, err)
+	require.Equal(t, "http://nonexistent-mirror-testnet:5551", baseURL)
+
+	contractCallURL, err := client.GetMirrorNodeClient().GetContractCallBaseURL()
+	require.NoError(t, err)
+	require.Equal(t, "http://nonexistent-mirror-testnet:8545", contractCallURL)
+
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	baseURL, err = client.GetMirrorNodeClient().GetBaseURL()
+	require.NoError(t, err)
+	require.Equal(t, "https://nonexistent-mirror-testnet", baseURL)
+
+	client.GetMirrorNodeClient().SetBaseURL("http://localhost:1234/api/v1/")
+	baseURL, err = client.GetMirrorNodeClient().GetBaseURL()
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:1234", baseURL)
+
+	client.mirrorNetwork = nil
+	client.GetMirrorNodeClient().baseURL = nil
+	_, err = client.GetMirrorNodeClient().GetBaseURL()
+	require.ErrorContains(t, err, "mirror node is not set")
+}
+
+func TestUnitMirrorNodeClientGetAccount(t *testing.T) {
+	t.Parallel()
+
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/accounts/0.0.1234", r.URL.Path)
+		_, _ = io.WriteString(w, `{
+			"account": "0.0.1234",
+			"evm_address": "0x00000000000000000000000000000000000004d2",
+			"ethereum_nonce": 7,
+			"memo": "hello",
+			"key": {"_type": "ED25519", "key": "abcd"},
+			"balance": {"balance": 100, "timestamp": "1700000000.000000001", "tokens": [{"token_id": "0.0.5", "balance": 3}]}
+		}`)
+	})
+
+	account, err := client.GetMirrorNodeClient().GetAccount(context.Background(), "0.0.1234")
+	require.NoError(t, err)
+	require.Equal(t, "0.0.1234", account.Account)
+	require.Equal(t, int64(7), account.EthereumNonce)
+	require.Equal(t, "hello", account.Memo)
+	require.Equal(t, "ED25519", account.Key.Type)
+	require.Equal(t, int64(100), account.Balance.Balance)
+	require.Equal(t, []MirrorNodeTokenBalance{{TokenID: "0.0.5", Balance: 3}}, account.Balance.Tokens)
+}
+
+func TestUnitMirrorNodeClientPagination(t *testing.T) {
+	t.Parallel()
+
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/tokens/0.0.5/nfts", r.URL.Path)
+		if r.URL.Query().Get("serialnumber") == "" {
+			require.Equal(t, "1", r.URL.Query().Get("limit"))
+			require.Equal(t, "asc", r.URL.Query().Get("order"))
+			_, _ = io.WriteString(w, `{"nfts": [{"token_id": "0.0.5", "serial_number": 1, "metadata": "AQI="}],
+				"links": {"next": "/api/v1/tokens/0.0.5/nfts?limit=1&order=asc&serialnumber=gt:1"}}`)
+			return
+		}
+		require.Equal(t, "gt:1", r.URL.Query().Get("serialnumber"))
+		_, _ = io.WriteString(w, `{"nfts": [{"token_id": "0.0.5", "serial_number": 2}], "links": {"next": null}}`)
+	})
+
+	page, err := client.GetMirrorNodeClient().GetTokenNfts(context.Background(), TokenID{Token: 5},
+		NewMirrorNodeQueryParams().SetLimit(1).SetOrder(MirrorNodeOrderAsc))
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	require.Equal(t, int64(1), page.Items[0].SerialNumber)
+	require.Equal(t, []byte{1, 2}, page.Items[0].Metadata)
+	require.True(t, page.HasNext())
+
+	page, err = page.Next(context.Background())
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	require.Equal(t, int64(2), page.Items[0].SerialNumber)
+	require.False(t, page.HasNext())
+
+	_, err = page.Next(context.Background())
+	require.Error(t, err)
+}
+
+func TestUnitMirrorNodeClientRetriesOnServerError(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = io.WriteString(w, `{"schedule_id": "0.0.9", "memo": "later"}`)
+	})
+
+	schedule, err := client.GetMirrorNodeClient().GetSchedule(context.Background(), ScheduleID{Schedule: 9})
+	require.NoError(t, err)
+	require.Equal(t, "0.0.9", schedule.ScheduleID)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestUnitMirrorNodeClientDoesNotRetryNotFound(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `{"_status": {"messages": [{"message": "Not found"}]}}`)
+	})
+
+	_, err := client.GetMirrorNodeClient().GetToken(context.Background(), TokenID{Token: 5})
+	var statusErr ErrMirrorNodeStatus
+	require.True(t, errors.As(err, &statusErr))
+	require.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+	require.Contains(t, statusErr.Details, "Not found")
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestUnitMirrorNodeClientContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	client.GetMirrorNodeClient().SetMaxBackoff(time.Minute).SetMinBackoff(time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetMirrorNodeClient().GetTransactions(ctx, nil)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestUnitMirrorNodeClientPopulate(t *testing.T) {
+	t.Parallel()
+
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/accounts/00000000000000000000000000000000000004d2":
+			_, _ = io.WriteString(w, `{"account": "0.0.1234"}`)
+		case "/api/v1/accounts/0.0.1234":
+			_, _ = io.WriteString(w, `{"account": "0.0.1234", "evm_address": "0x00000000000000000000000000000000000004d2"}`)
+		case "/api/v1/contracts/00000000000000000000000000000000000004d2":
+			_, _ = io.WriteString(w, `{"contract_id": "0.0.1234"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	accountID, err := AccountIDFromEvmAddress(0, 0, "00000000000000000000000000000000000004d2")
+	require.NoError(t, err)
+	require.NoError(t, accountID.PopulateAccount(client))
+	require.Equal(t, uint64(1234), accountID.Account)
+
+	accountID = AccountID{Account: 1234}
+	require.NoError(t, accountID.PopulateEvmAddress(client))
+	require.NotNil(t, accountID.AliasEvmAddress)
+	require.Equal(t, byte(0xd2), (*accountID.AliasEvmAddress)[19])
+
+	contractID, err := ContractIDFromEvmAddress(0, 0, "00000000000000000000000000000000000004d2")
+	require.NoError(t, err)
+	require.NoError(t, contractID.PopulateContract(client))
+	require.Equal(t, uint64(1234), contractID.Contract)
+}
+
+func TestUnitMirrorNodeClientContractCall(t *testing.T) {
+	t.Parallel()
+
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v1/contracts/call", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		_, _ = io.WriteString(w, `{"result": "0x5208"}`)
+	})
+
+	gas, err := NewMirrorNodeContractEstimateGasQuery().
+		SetContractID(ContractID{Contract: 1234}).
+		SetFunction("foo", nil).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), gas)
+
+	result, err := NewMirrorNodeContractCallQuery().
+		SetContractID(ContractID{Contract: 1234}).
+		SetFunction("foo", nil).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, "0x5208", result)
+}
+
+func TestUnitMirrorNodeTimestampAndTransactionID(t *testing.T) {
+	t.Parallel()
+
+	timestamp, err := ParseMirrorNodeTimestamp("1700000000.000000123")
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1700000000, 123), timestamp)
+
+	timestamp, err = ParseMirrorNodeTimestamp("1700000000.5")
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1700000000, 500000000), timestamp)
+
+	_, err = ParseMirrorNodeTimestamp("abc")
+	require.Error(t, err)
+
+	validStart := time.Unix(1700000000, 1)
+	transactionID := TransactionID{AccountID: &AccountID{Account: 2}, ValidStart: &validStart}
+	require.Equal(t, "0.0.2-1700000000-000000001", _TransactionIDToMirrorNodeString(transactionID))
+}
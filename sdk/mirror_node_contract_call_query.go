@@ -2,6 +2,8 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
+import "context"
+
 // MirrorNodeContractCallQuery returns a result from EVM transient simulation of read-write operations.
 type MirrorNodeContractCallQuery struct {
 	mirrorNodeContractQuery
@@ -79,5 +81,10 @@ func (mirrorNodeContractCallQuery *MirrorNodeContractCallQuery) SetBlockNumber(b
 
 // Does transient simulation of read-write operations and returns the result in hexadecimal string format.
 func (mirrorNodeContractCallQuery *MirrorNodeContractCallQuery) Execute(client *Client) (string, error) {
-	return mirrorNodeContractCallQuery.call(client)
+	return mirrorNodeContractCallQuery.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext is Execute, stopping early when ctx is done.
+func (mirrorNodeContractCallQuery *MirrorNodeContractCallQuery) ExecuteWithContext(ctx context.Context, client *Client) (string, error) {
+	return mirrorNodeContractCallQuery.call(ctx, client)
 }
@@ -1,12 +1,10 @@
 package hiero
 
 import (
-	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"strconv"
 	"strings"
 
@@ -122,7 +120,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) GetBlockNumber() int64 {
 }
 
 // Returns gas estimation for the EVM execution
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Client) (uint64, error) {
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(ctx context.Context, client *Client) (uint64, error) {
 	err := mirrorNodeContractQuery.fillEvmAddresses()
 	if err != nil {
 		return 0, err
@@ -133,15 +131,11 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Clie
 		return 0, err
 	}
 
-	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(client, jsonPayload)
+	hexString, err := mirrorNodeContractQuery.performContractCallToMirrorNode(ctx, client, jsonPayload)
 	if err != nil {
 		return 0, err
 	}
 
-	hexString, ok := result["result"].(string)
-	if !ok {
-		return 0, fmt.Errorf("result is not a string")
-	}
 	hexString = strings.TrimPrefix(hexString, "0x")
 	gas, err := strconv.ParseUint(hexString, 16, 64)
 	if err != nil {
@@ -151,7 +145,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Clie
 }
 
 // Does transient simulation of read-write operations and returns the result in hexadecimal string format. The result can be any solidity type.
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(client *Client) (string, error) {
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(ctx context.Context, client *Client) (string, error) {
 	err := mirrorNodeContractQuery.fillEvmAddresses()
 	if err != nil {
 		return "", err
@@ -168,16 +162,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(client *Client) (st
 		return "", err
 	}
 
-	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(client, jsonPayload)
-	if err != nil {
-		return "", err
-	}
-
-	hexString, ok := result["result"].(string)
-	if !ok {
-		return "", fmt.Errorf("result is not a string")
-	}
-	return hexString, nil
+	return mirrorNodeContractQuery.performContractCallToMirrorNode(ctx, client, jsonPayload)
 }
 
 // Retrieve and set the evm addresses if necessary
@@ -199,43 +184,12 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) fillEvmAddresses() error
 	return nil
 }
 
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) performContractCallToMirrorNode(client *Client, jsonPayload string) (map[string]any, error) {
-	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return nil, errors.New("mirror node is not set")
-	}
-	mirrorUrl := client.GetMirrorNetwork()[0]
-	index := strings.Index(mirrorUrl, ":")
-	if index == -1 {
-		return nil, errors.New("invalid mirrorUrl format")
-	}
-	mirrorUrl = mirrorUrl[:index]
-
-	var url string
-	protocol := "https"
-	port := ""
-
-	if client.GetLedgerID() == nil {
-		protocol = "http"
-		port = ":8545"
-	}
-	url = fmt.Sprintf("%s://%s%s/api/v1/contracts/call", protocol, mirrorUrl, port)
-
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer([]byte(jsonPayload))) // #nosec
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("received non-200 response from Mirror Node: %d, details: %s", resp.StatusCode, body)
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) performContractCallToMirrorNode(ctx context.Context, client *Client, jsonPayload string) (string, error) {
+	if client == nil {
+		return "", errNoClientProvided
 	}
 
-	var result map[string]any
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-	return result, nil
+	return client.GetMirrorNodeClient()._ContractCall(ctx, []byte(jsonPayload))
 }
 
 func (mirrorNodeContractQuery *mirrorNodeContractQuery) createJSONPayload(estimate bool, blockNumber string) (string, error) {
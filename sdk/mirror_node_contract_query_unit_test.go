@@ -6,6 +6,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -125,7 +126,7 @@ func TestMirrorNodeContractQuerySetAndGetGasPrice(t *testing.T) {
 func TestMirrorNodeContractQueryEstimateGasWithMissingContractIDOrEvmAddressThrowsException(t *testing.T) {
 	query1 := &mirrorNodeContractQuery{}
 	query1.setFunction("testFunction", NewContractFunctionParameters().AddString("params"))
-	_, err1 := query1.estimateGas(nil)
+	_, err1 := query1.estimateGas(context.Background(), nil)
 	require.Error(t, err1)
 
 	query2 := NewMirrorNodeContractEstimateGasQuery()
@@ -0,0 +1,265 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+// The types in this file mirror the JSON documents returned by the mirror node REST API. Entity IDs are kept
+// in their "shard.realm.num" string form and timestamps in their "seconds.nanoseconds" form as returned by the
+// mirror node; use the ...FromString functions and ParseMirrorNodeTimestamp to convert them.
+
+// MirrorNodeKey is a key as returned by the mirror node
+type MirrorNodeKey struct {
+	Type string `json:"_type"`
+	Key  string `json:"key"`
+}
+
+// MirrorNodeTokenBalance is the balance of a single token held by an account
+type MirrorNodeTokenBalance struct {
+	TokenID string `json:"token_id"`
+	Balance int64  `json:"balance"`
+}
+
+// MirrorNodeAccountBalance is the balance snapshot embedded in an account
+type MirrorNodeAccountBalance struct {
+	Balance   int64                    `json:"balance"`
+	Timestamp string                   `json:"timestamp"`
+	Tokens    []MirrorNodeTokenBalance `json:"tokens"`
+}
+
+// MirrorNodeAccount is an account as returned by /accounts
+type MirrorNodeAccount struct {
+	Account                       string                    `json:"account"`
+	Alias                         *string                   `json:"alias"`
+	AutoRenewPeriod               *int64                    `json:"auto_renew_period"`
+	Balance                       *MirrorNodeAccountBalance `json:"balance"`
+	CreatedTimestamp              *string                   `json:"created_timestamp"`
+	DeclineReward                 bool                      `json:"decline_reward"`
+	Deleted                       bool                      `json:"deleted"`
+	EthereumNonce                 int64                     `json:"ethereum_nonce"`
+	EvmAddress                    string                    `json:"evm_address"`
+	ExpiryTimestamp               *string                   `json:"expiry_timestamp"`
+	Key                           *MirrorNodeKey            `json:"key"`
+	MaxAutomaticTokenAssociations int32                     `json:"max_automatic_token_associations"`
+	Memo                          string                    `json:"memo"`
+	PendingReward                 int64                     `json:"pending_reward"`
+	ReceiverSigRequired           bool                      `json:"receiver_sig_required"`
+	StakedAccountID               *string                   `json:"staked_account_id"`
+	StakedNodeID                  *int64                    `json:"staked_node_id"`
+	StakePeriodStart              *string                   `json:"stake_period_start"`
+}
+
+// MirrorNodeBalance is an account balance as returned by /balances
+type MirrorNodeBalance struct {
+	Account string                   `json:"account"`
+	Balance int64                    `json:"balance"`
+	Tokens  []MirrorNodeTokenBalance `json:"tokens"`
+}
+
+// MirrorNodeToken is a token summary as returned by the /tokens list
+type MirrorNodeToken struct {
+	TokenID  string         `json:"token_id"`
+	Name     string         `json:"name"`
+	Symbol   string         `json:"symbol"`
+	Type     string         `json:"type"`
+	Decimals int64          `json:"decimals"`
+	Metadata []byte         `json:"metadata"`
+	AdminKey *MirrorNodeKey `json:"admin_key"`
+}
+
+// MirrorNodeTokenInfo is the detailed view of a token as returned by /tokens/{tokenId}. The mirror node returns
+// the supply and decimals fields as strings to preserve precision.
+type MirrorNodeTokenInfo struct {
+	TokenID           string         `json:"token_id"`
+	Name              string         `json:"name"`
+	Symbol            string         `json:"symbol"`
+	Type              string         `json:"type"`
+	Decimals          string         `json:"decimals"`
+	InitialSupply     string         `json:"initial_supply"`
+	TotalSupply       string         `json:"total_supply"`
+	MaxSupply         string         `json:"max_supply"`
+	SupplyType        string         `json:"supply_type"`
+	TreasuryAccountID string         `json:"treasury_account_id"`
+	AutoRenewAccount  *string        `json:"auto_renew_account"`
+	AutoRenewPeriod   *int64         `json:"auto_renew_period"`
+	CreatedTimestamp  string         `json:"created_timestamp"`
+	ModifiedTimestamp string         `json:"modified_timestamp"`
+	ExpiryTimestamp   *int64         `json:"expiry_timestamp"`
+	Deleted           bool           `json:"deleted"`
+	FreezeDefault     bool           `json:"freeze_default"`
+	PauseStatus       string         `json:"pause_status"`
+	Memo              string         `json:"memo"`
+	Metadata          []byte         `json:"metadata"`
+	AdminKey          *MirrorNodeKey `json:"admin_key"`
+	FeeScheduleKey    *MirrorNodeKey `json:"fee_schedule_key"`
+	FreezeKey         *MirrorNodeKey `json:"freeze_key"`
+	KycKey            *MirrorNodeKey `json:"kyc_key"`
+	MetadataKey       *MirrorNodeKey `json:"metadata_key"`
+	PauseKey          *MirrorNodeKey `json:"pause_key"`
+	SupplyKey         *MirrorNodeKey `json:"supply_key"`
+	WipeKey           *MirrorNodeKey `json:"wipe_key"`
+}
+
+// MirrorNodeNft is a non-fungible token as returned by /tokens/{tokenId}/nfts and /accounts/{idOrAlias}/nfts
+type MirrorNodeNft struct {
+	AccountID         *string `json:"account_id"`
+	CreatedTimestamp  string  `json:"created_timestamp"`
+	DelegatingSpender *string `json:"delegating_spender"`
+	Deleted           bool    `json:"deleted"`
+	Metadata          []byte  `json:"metadata"`
+	ModifiedTimestamp string  `json:"modified_timestamp"`
+	SerialNumber      int64   `json:"serial_number"`
+	Spender           *string `json:"spender"`
+	TokenID           string  `json:"token_id"`
+}
+
+// MirrorNodeTransfer is an hbar transfer of a transaction
+type MirrorNodeTransfer struct {
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+// MirrorNodeTokenTransfer is a fungible token transfer of a transaction
+type MirrorNodeTokenTransfer struct {
+	TokenID    string `json:"token_id"`
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+// MirrorNodeNftTransfer is an NFT transfer of a transaction
+type MirrorNodeNftTransfer struct {
+	TokenID           string  `json:"token_id"`
+	SerialNumber      int64   `json:"serial_number"`
+	SenderAccountID   *string `json:"sender_account_id"`
+	ReceiverAccountID *string `json:"receiver_account_id"`
+	IsApproval        bool    `json:"is_approval"`
+}
+
+// MirrorNodeTransaction is a transaction as returned by /transactions
+type MirrorNodeTransaction struct {
+	ConsensusTimestamp       string                    `json:"consensus_timestamp"`
+	ChargedTxFee             int64                     `json:"charged_tx_fee"`
+	EntityID                 *string                   `json:"entity_id"`
+	MaxFee                   string                    `json:"max_fee"`
+	MemoBase64               []byte                    `json:"memo_base64"`
+	Name                     string                    `json:"name"`
+	Node                     *string                   `json:"node"`
+	Nonce                    int32                     `json:"nonce"`
+	ParentConsensusTimestamp *string                   `json:"parent_consensus_timestamp"`
+	Result                   string                    `json:"result"`
+	Scheduled                bool                      `json:"scheduled"`
+	TransactionHash          []byte                    `json:"transaction_hash"`
+	TransactionID            string                    `json:"transaction_id"`
+	ValidDurationSeconds     string                    `json:"valid_duration_seconds"`
+	ValidStartTimestamp      string                    `json:"valid_start_timestamp"`
+	Transfers                []MirrorNodeTransfer      `json:"transfers"`
+	TokenTransfers           []MirrorNodeTokenTransfer `json:"token_transfers"`
+	NftTransfers             []MirrorNodeNftTransfer   `json:"nft_transfers"`
+}
+
+// MirrorNodeContract is a contract as returned by /contracts
+type MirrorNodeContract struct {
+	ContractID                    string         `json:"contract_id"`
+	EvmAddress                    string         `json:"evm_address"`
+	AdminKey                      *MirrorNodeKey `json:"admin_key"`
+	AutoRenewAccount              *string        `json:"auto_renew_account"`
+	AutoRenewPeriod               *int64         `json:"auto_renew_period"`
+	CreatedTimestamp              *string        `json:"created_timestamp"`
+	Deleted                       bool           `json:"deleted"`
+	ExpirationTimestamp           *string        `json:"expiration_timestamp"`
+	FileID                        *string        `json:"file_id"`
+	MaxAutomaticTokenAssociations int32          `json:"max_automatic_token_associations"`
+	Memo                          string         `json:"memo"`
+	Nonce                         *int64         `json:"nonce"`
+	Bytecode                      *string        `json:"bytecode"`
+	RuntimeBytecode               *string        `json:"runtime_bytecode"`
+}
+
+// MirrorNodeContractLog is a log emitted by a contract as returned by /contracts/{idOrAddress}/results/logs
+type MirrorNodeContractLog struct {
+	Address          string   `json:"address"`
+	BlockHash        string   `json:"block_hash"`
+	BlockNumber      int64    `json:"block_number"`
+	Bloom            string   `json:"bloom"`
+	ContractID       string   `json:"contract_id"`
+	Data             string   `json:"data"`
+	Index            int64    `json:"index"`
+	RootContractID   *string  `json:"root_contract_id"`
+	Timestamp        string   `json:"timestamp"`
+	Topics           []string `json:"topics"`
+	TransactionHash  string   `json:"transaction_hash"`
+	TransactionIndex int64    `json:"transaction_index"`
+}
+
+// MirrorNodeContractResult is the result of a contract call as returned by /contracts/results
+type MirrorNodeContractResult struct {
+	Address            string                  `json:"address"`
+	Amount             int64                   `json:"amount"`
+	BlockHash          string                  `json:"block_hash"`
+	BlockNumber        int64                   `json:"block_number"`
+	Bloom              string                  `json:"bloom"`
+	CallResult         string                  `json:"call_result"`
+	ContractID         string                  `json:"contract_id"`
+	CreatedContractIDs []string                `json:"created_contract_ids"`
+	ErrorMessage       *string                 `json:"error_message"`
+	From               string                  `json:"from"`
+	FunctionParameters string                  `json:"function_parameters"`
+	GasConsumed        *int64                  `json:"gas_consumed"`
+	GasLimit           int64                   `json:"gas_limit"`
+	GasUsed            int64                   `json:"gas_used"`
+	Hash               string                  `json:"hash"`
+	Nonce              *int64                  `json:"nonce"`
+	Result             string                  `json:"result"`
+	Status             string                  `json:"status"`
+	Timestamp          string                  `json:"timestamp"`
+	To                 *string                 `json:"to"`
+	Logs               []MirrorNodeContractLog `json:"logs"`
+}
+
+// MirrorNodeChunkInfo describes the position of a topic message within a chunked message
+type MirrorNodeChunkInfo struct {
+	InitialTransactionID *struct {
+		AccountID             string `json:"account_id"`
+		Nonce                 int32  `json:"nonce"`
+		Scheduled             bool   `json:"scheduled"`
+		TransactionValidStart string `json:"transaction_valid_start"`
+	} `json:"initial_transaction_id"`
+	Number int32 `json:"number"`
+	Total  int32 `json:"total"`
+}
+
+// MirrorNodeTopicMessage is a topic message as returned by /topics/{topicId}/messages
+type MirrorNodeTopicMessage struct {
+	ChunkInfo          *MirrorNodeChunkInfo `json:"chunk_info"`
+	ConsensusTimestamp string               `json:"consensus_timestamp"`
+	Message            []byte               `json:"message"`
+	PayerAccountID     string               `json:"payer_account_id"`
+	RunningHash        []byte               `json:"running_hash"`
+	RunningHashVersion int64                `json:"running_hash_version"`
+	SequenceNumber     uint64               `json:"sequence_number"`
+	TopicID            string               `json:"topic_id"`
+}
+
+// MirrorNodeScheduleSignature is a signature collected by a schedule
+type MirrorNodeScheduleSignature struct {
+	ConsensusTimestamp string `json:"consensus_timestamp"`
+	PublicKeyPrefix    []byte `json:"public_key_prefix"`
+	Signature          []byte `json:"signature"`
+	Type               string `json:"type"`
+}
+
+// MirrorNodeSchedule is a schedule as returned by /schedules
+type MirrorNodeSchedule struct {
+	AdminKey           *MirrorNodeKey                `json:"admin_key"`
+	ConsensusTimestamp string                        `json:"consensus_timestamp"`
+	CreatorAccountID   string                        `json:"creator_account_id"`
+	Deleted            bool                          `json:"deleted"`
+	ExecutedTimestamp  *string                       `json:"executed_timestamp"`
+	ExpirationTime     *string                       `json:"expiration_time"`
+	Memo               string                        `json:"memo"`
+	PayerAccountID     string                        `json:"payer_account_id"`
+	ScheduleID         string                        `json:"schedule_id"`
+	Signatures         []MirrorNodeScheduleSignature `json:"signatures"`
+	TransactionBody    []byte                        `json:"transaction_body"`
+	WaitForExpiry      bool                          `json:"wait_for_expiry"`
+}
@@ -3,19 +3,21 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
-	"math/rand"
+	"sort"
 	"time"
 )
 
 type _Network struct {
 	_ManagedNetwork
-	addressBook map[AccountID]NodeAddress
+	addressBook  map[AccountID]NodeAddress
+	nodeSelector NodeSelector
 }
 
 func _NewNetwork() _Network {
 	return _Network{
 		_ManagedNetwork: _NewManagedNetwork(),
 		addressBook:     nil,
+		nodeSelector:    NewRandomNodeSelector(),
 	}
 }
 
@@ -47,21 +49,87 @@ func (network *_Network) _GetNetwork() map[string]AccountID {
 }
 
 func (network *_Network) _IncreaseBackoff(node *_Node) {
+	network._ManagedNetwork._IncreaseBackoff(node)
+}
+
+// _GetNodeHealth returns the health of every node of the network, ordered by account ID and address
+func (network *_Network) _GetNodeHealth() []NodeHealth {
+	network.healthyNodesMutex.RLock()
+	nodes := make([]_IManagedNode, len(network.nodes))
+	copy(nodes, network.nodes)
+	network.healthyNodesMutex.RUnlock()
+
+	health := make([]NodeHealth, 0, len(nodes))
+	for _, managed := range nodes {
+		if node, ok := managed.(*_Node); ok {
+			nodeHealth := node._ManagedNode._GetHealth()
+			nodeHealth.AccountID = node.accountID
+			health = append(health, nodeHealth)
+		}
+	}
+
+	sort.Slice(health, func(i, j int) bool {
+		if c := health[i].AccountID.Compare(health[j].AccountID); c != 0 {
+			return c < 0
+		}
+		return health[i].Address < health[j].Address
+	})
+
+	return health
+}
+
+// _QuarantineNode takes every address of the node out of use for the duration
+func (network *_Network) _QuarantineNode(id AccountID, duration time.Duration) error {
 	network.healthyNodesMutex.Lock()
 	defer network.healthyNodesMutex.Unlock()
-	node._IncreaseBackoff()
 
-	index := -1
-	for i, healthyNode := range network.healthyNodes {
-		if node == healthyNode {
-			index = i
-			break
+	nodes, ok := network.network[id.String()]
+	if !ok || len(nodes) == 0 {
+		return ErrUnknownNode{NodeAccountID: id}
+	}
+
+	until := time.Now().Add(duration)
+	healthyNodes := make([]_IManagedNode, 0, len(network.healthyNodes))
+	for _, healthyNode := range network.healthyNodes {
+		if node, ok := healthyNode.(*_Node); ok && node.accountID.Compare(id) == 0 {
+			continue
 		}
+		healthyNodes = append(healthyNodes, healthyNode)
+	}
+	network.healthyNodes = healthyNodes
+
+	for _, node := range nodes {
+		node._GetManagedNode()._Quarantine(until)
 	}
+	if until.Before(network.earliestReadmitTime) {
+		network.earliestReadmitTime = until
+	}
+
+	return nil
+}
 
-	if index >= 0 && index < len(network.healthyNodes) {
-		network.healthyNodes = append(network.healthyNodes[:index], network.healthyNodes[index+1:]...)
+// _ReadmitNode puts every address of the node back in use, with its minimum backoff
+func (network *_Network) _ReadmitNode(id AccountID) error {
+	network.healthyNodesMutex.Lock()
+	defer network.healthyNodesMutex.Unlock()
+
+	nodes, ok := network.network[id.String()]
+	if !ok || len(nodes) == 0 {
+		return ErrUnknownNode{NodeAccountID: id}
 	}
+
+outer:
+	for _, node := range nodes {
+		node._GetManagedNode()._Readmit()
+		for _, healthyNode := range network.healthyNodes {
+			if healthyNode == node {
+				continue outer
+			}
+		}
+		network.healthyNodes = append(network.healthyNodes, node)
+	}
+
+	return nil
 }
 
 func (network *_Network) _GetNodeForAccountID(id AccountID) (*_Node, bool) {
@@ -72,8 +140,80 @@ func (network *_Network) _GetNodeForAccountID(id AccountID) (*_Node, bool) {
 	return node[0].(*_Node), ok
 }
 
-func (network *_Network) _GetNode() *_Node {
-	return network._ManagedNetwork._GetNode().(*_Node)
+func (network *_Network) _GetNode() (*_Node, error) {
+	nodes, err := network._SelectNodes(1)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+func (network *_Network) _SetNodeSelector(selector NodeSelector) {
+	if selector == nil {
+		selector = NewRandomNodeSelector()
+	}
+	network.nodeSelector = selector
+}
+
+func (network *_Network) _GetNodeSelector() NodeSelector {
+	return network.nodeSelector
+}
+
+// _SelectNodes returns count healthy nodes, or all of them when there are fewer, in the order of the node selector
+func (network *_Network) _SelectNodes(count int) ([]*_Node, error) {
+	network._ReadmitNodes()
+	network.healthyNodesMutex.RLock()
+	healthyNodes := make(map[AccountID]*_Node, len(network.healthyNodes))
+	candidates := make([]NodeCandidate, 0, len(network.healthyNodes))
+	for _, healthyNode := range network.healthyNodes {
+		node, ok := healthyNode.(*_Node)
+		if !ok {
+			continue
+		}
+		if _, ok := healthyNodes[node.accountID]; ok {
+			continue
+		}
+		healthyNodes[node.accountID] = node
+		candidates = append(candidates, NodeCandidate{
+			AccountID: node.accountID,
+			Address:   node._GetAddress(),
+			Latency:   node._GetLatency(),
+			InFlight:  node._GetInFlight(),
+			Stake:     network.addressBook[node.accountID].Stake,
+		})
+	}
+	network.healthyNodesMutex.RUnlock()
+
+	if len(candidates) == 0 {
+		return nil, errNoHealthyNodes
+	}
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	nodes := make([]*_Node, 0, count)
+	for _, candidate := range network.nodeSelector.SelectNodes(candidates, count) {
+		if len(nodes) == count {
+			break
+		}
+		// Nodes the selector made up or returned twice are ignored
+		if node, ok := healthyNodes[candidate.AccountID]; ok {
+			nodes = append(nodes, node)
+			delete(healthyNodes, candidate.AccountID)
+		}
+	}
+	// A selector returning fewer nodes than asked for is topped up in the order of the candidates
+	for _, candidate := range candidates {
+		if len(nodes) == count {
+			break
+		}
+		if node, ok := healthyNodes[candidate.AccountID]; ok {
+			nodes = append(nodes, node)
+			delete(healthyNodes, candidate.AccountID)
+		}
+	}
+
+	return nodes, nil
 }
 
 func (network *_Network) _GetLedgerID() *LedgerID {
@@ -116,25 +256,17 @@ func (network *_Network) _SetLedgerID(id LedgerID) {
 	}
 }
 
-func (network *_Network) _GetNodeAccountIDsForExecute() []AccountID { //nolint
-	nodes := make([]AccountID, 0)
-	nodesForTransaction := network._GetNumberOfNodesForTransaction()
-
-	network.healthyNodesMutex.RLock()
-	defer network.healthyNodesMutex.RUnlock()
-
-	healthyNodes := make([]_IManagedNode, len(network.healthyNodes))
-	copy(healthyNodes, network.healthyNodes)
-
-	// shuffle the nodes, so that the first node is not always the same
-	for i := range healthyNodes {
-		j := rand.Intn(i + 1) // #nosec
-		healthyNodes[i], healthyNodes[j] = healthyNodes[j], healthyNodes[i]
+func (network *_Network) _GetNodeAccountIDsForExecute() ([]AccountID, error) { //nolint
+	nodes, err := network._SelectNodes(network._GetNumberOfNodesForTransaction())
+	if err != nil {
+		return nil, err
 	}
-	for i := 0; i < nodesForTransaction; i++ {
-		nodes = append(nodes, healthyNodes[i].(*_Node).accountID)
+
+	accountIDs := make([]AccountID, 0, len(nodes))
+	for _, node := range nodes {
+		accountIDs = append(accountIDs, node.accountID)
 	}
-	return nodes
+	return accountIDs, nil
 }
 
 func (network *_Network) _SetMaxNodesPerTransaction(max int) {
@@ -0,0 +1,182 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+// NetworkGetExecutionTimeQuery
+// Get the time the network spent executing each of a list of transactions, excluding pre-consensus
+// processing, consensus and record stream generation.
+//
+// Deprecated: the query is obsolete and current consensus nodes reject it with NOT_SUPPORTED.
+type NetworkGetExecutionTimeQuery struct {
+	Query
+	transactionIDs []TransactionID
+}
+
+// NewNetworkGetExecutionTimeQuery
+// Creates a NetworkGetExecutionTimeQuery which retrieves the execution time of each of the given transactions.
+//
+// Deprecated: the query is obsolete and current consensus nodes reject it with NOT_SUPPORTED.
+func NewNetworkGetExecutionTimeQuery() *NetworkGetExecutionTimeQuery {
+	header := services.QueryHeader{}
+	return &NetworkGetExecutionTimeQuery{
+		Query: _NewQuery(true, &header),
+	}
+}
+
+func (q *NetworkGetExecutionTimeQuery) GetCost(client *Client) (Hbar, error) {
+	return q.Query.getCost(context.Background(), client, q)
+}
+
+// Execute executes the Query with the provided client. The returned durations are in the
+// same order as the transaction IDs.
+func (q *NetworkGetExecutionTimeQuery) Execute(client *Client) ([]time.Duration, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *NetworkGetExecutionTimeQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]time.Duration, error) {
+	resp, err := q.execute(ctx, client, q)
+
+	if err != nil {
+		return []time.Duration{}, err
+	}
+
+	executionTimes := resp.GetNetworkGetExecutionTime().ExecutionTimes
+	durations := make([]time.Duration, len(executionTimes))
+	for i, executionTime := range executionTimes {
+		durations[i] = time.Duration(executionTime)
+	}
+
+	return durations, nil
+}
+
+// SetGrpcDeadline When execution is attempted, a single attempt will timeout when this deadline is reached. (The SDK may subsequently retry the execution.)
+func (q *NetworkGetExecutionTimeQuery) SetGrpcDeadline(deadline *time.Duration) *NetworkGetExecutionTimeQuery {
+	q.Query.SetGrpcDeadline(deadline)
+	return q
+}
+
+// SetTransactionIDs sets the transactions whose execution time is requested.
+func (q *NetworkGetExecutionTimeQuery) SetTransactionIDs(transactionIDs []TransactionID) *NetworkGetExecutionTimeQuery {
+	q.transactionIDs = transactionIDs
+	return q
+}
+
+// AddTransactionID adds a transaction whose execution time is requested.
+func (q *NetworkGetExecutionTimeQuery) AddTransactionID(transactionID TransactionID) *NetworkGetExecutionTimeQuery {
+	q.transactionIDs = append(q.transactionIDs, transactionID)
+	return q
+}
+
+// GetTransactionIDs returns the transactions whose execution time is requested.
+func (q *NetworkGetExecutionTimeQuery) GetTransactionIDs() []TransactionID {
+	return q.transactionIDs
+}
+
+// SetNodeAccountIDs sets the _Node AccountID for this NetworkGetExecutionTimeQuery.
+func (q *NetworkGetExecutionTimeQuery) SetNodeAccountIDs(accountID []AccountID) *NetworkGetExecutionTimeQuery {
+	q.Query.SetNodeAccountIDs(accountID)
+	return q
+}
+
+// SetQueryPayment sets the Hbar payment to pay the _Node a fee for handling this query
+func (q *NetworkGetExecutionTimeQuery) SetQueryPayment(queryPayment Hbar) *NetworkGetExecutionTimeQuery {
+	q.queryPayment = queryPayment
+	return q
+}
+
+// SetMaxQueryPayment sets the maximum payment allowable for this query.
+func (q *NetworkGetExecutionTimeQuery) SetMaxQueryPayment(queryMaxPayment Hbar) *NetworkGetExecutionTimeQuery {
+	q.maxQueryPayment = queryMaxPayment
+	return q
+}
+
+// SetMaxRetry sets the max number of errors before execution will fail.
+func (q *NetworkGetExecutionTimeQuery) SetMaxRetry(count int) *NetworkGetExecutionTimeQuery {
+	q.Query.SetMaxRetry(count)
+	return q
+}
+
+// SetMaxBackoff The maximum amount of time to wait between retries. Every retry attempt will increase the wait time exponentially until it reaches this time.
+func (q *NetworkGetExecutionTimeQuery) SetMaxBackoff(max time.Duration) *NetworkGetExecutionTimeQuery {
+	q.Query.SetMaxBackoff(max)
+	return q
+}
+
+// SetMinBackoff sets the minimum amount of time to wait between retries.
+func (q *NetworkGetExecutionTimeQuery) SetMinBackoff(min time.Duration) *NetworkGetExecutionTimeQuery {
+	q.Query.SetMinBackoff(min)
+	return q
+}
+
+// SetPaymentTransactionID assigns the payment transaction id.
+func (q *NetworkGetExecutionTimeQuery) SetPaymentTransactionID(transactionID TransactionID) *NetworkGetExecutionTimeQuery {
+	q.Query.SetPaymentTransactionID(transactionID)
+	return q
+}
+
+func (q *NetworkGetExecutionTimeQuery) SetLogLevel(level LogLevel) *NetworkGetExecutionTimeQuery {
+	q.Query.SetLogLevel(level)
+	return q
+}
+
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *NetworkGetExecutionTimeQuery) SetRetryPolicy(policy RetryPolicy) *NetworkGetExecutionTimeQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
+// ---------- Parent functions specific implementation ----------
+
+func (q *NetworkGetExecutionTimeQuery) getMethod(channel *_Channel) _Method {
+	return _Method{
+		query: channel._GetNetwork().GetExecutionTime,
+	}
+}
+
+func (q *NetworkGetExecutionTimeQuery) getName() string {
+	return "NetworkGetExecutionTimeQuery"
+}
+
+func (q *NetworkGetExecutionTimeQuery) buildQuery() *services.Query {
+	pbQuery := services.Query_NetworkGetExecutionTime{
+		NetworkGetExecutionTime: &services.NetworkGetExecutionTimeQuery{ // nolint
+			Header: q.pbHeader,
+		},
+	}
+
+	for _, transactionID := range q.transactionIDs {
+		pbQuery.NetworkGetExecutionTime.TransactionIds = append(pbQuery.NetworkGetExecutionTime.TransactionIds, transactionID._ToProtobuf())
+	}
+
+	return &services.Query{
+		Query: &pbQuery,
+	}
+}
+
+func (q *NetworkGetExecutionTimeQuery) validateNetworkOnIDs(client *Client) error {
+	if client == nil || !client.autoValidateChecksums {
+		return nil
+	}
+
+	for _, transactionID := range q.transactionIDs {
+		if transactionID.AccountID != nil {
+			if err := transactionID.AccountID.ValidateChecksum(client); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (q *NetworkGetExecutionTimeQuery) getQueryResponse(response *services.Response) queryResponse {
+	return response.GetNetworkGetExecutionTime()
+}
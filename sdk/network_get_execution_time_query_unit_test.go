@@ -0,0 +1,77 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitNetworkGetExecutionTimeQueryValidate(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	require.NoError(t, err)
+	client.SetAutoValidateChecksums(true)
+
+	accountID, err := AccountIDFromString("0.0.123-esxsf")
+	require.NoError(t, err)
+	query := NewNetworkGetExecutionTimeQuery().
+		AddTransactionID(TransactionIDGenerate(accountID))
+	require.NoError(t, query.validateNetworkOnIDs(client))
+
+	accountID, err = AccountIDFromString("0.0.123-rmkykd")
+	require.NoError(t, err)
+	query.AddTransactionID(TransactionIDGenerate(accountID))
+	require.Error(t, query.validateNetworkOnIDs(client))
+}
+
+func TestUnitNetworkGetExecutionTimeQueryMock(t *testing.T) {
+	t.Parallel()
+
+	var requested []*services.TransactionID
+	call := func(request *services.Query) *services.Response {
+		requested = request.GetNetworkGetExecutionTime().TransactionIds
+		return &services.Response{
+			Response: &services.Response_NetworkGetExecutionTime{
+				NetworkGetExecutionTime: &services.NetworkGetExecutionTimeResponse{
+					Header:         &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					ExecutionTimes: []uint64{1500, uint64(2 * time.Millisecond)},
+				},
+			},
+		}
+	}
+	busy := &services.Response{
+		Response: &services.Response_NetworkGetExecutionTime{
+			NetworkGetExecutionTime: &services.NetworkGetExecutionTimeResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY, ResponseType: services.ResponseType_ANSWER_ONLY},
+			},
+		},
+	}
+	responses := [][]interface{}{{busy, call}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	first := TransactionIDGenerate(AccountID{Account: 1800})
+	second := TransactionIDGenerate(AccountID{Account: 1801})
+	durations, err := NewNetworkGetExecutionTimeQuery().
+		SetTransactionIDs([]TransactionID{first}).
+		AddTransactionID(second).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetQueryPayment(NewHbar(1)).
+		SetMinBackoff(time.Millisecond).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{1500 * time.Nanosecond, 2 * time.Millisecond}, durations)
+	require.Len(t, requested, 2)
+	require.Equal(t, first.String(), _TransactionIDFromProtobuf(requested[0]).String())
+	require.Equal(t, second.String(), _TransactionIDFromProtobuf(requested[1]).String())
+}
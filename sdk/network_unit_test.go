@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -46,7 +47,8 @@ func TestUnitNetworkIncreaseBackoffConcurrent(t *testing.T) {
 	err := network.SetNetwork(nodes)
 	require.NoError(t, err)
 
-	node := network._GetNode()
+	node, err := network._GetNode()
+	require.NoError(t, err)
 	require.NotNil(t, node)
 
 	numThreads := 20
@@ -83,7 +85,10 @@ func TestUnitConcurrentGetNodeReadmit(t *testing.T) {
 	for i := 0; i < numThreads; i++ {
 		go func() {
 			for i := 0; i < 20; i++ {
-				node := network._GetNode()
+				node, err := network._GetNode()
+				if !assert.NoError(t, err) {
+					break
+				}
 				network._IncreaseBackoff(node)
 			}
 			wg.Done()
@@ -110,12 +115,13 @@ func TestUnitConcurrentNodeAccess(t *testing.T) {
 
 	numThreads := 3
 	var wg sync.WaitGroup
-	node := network._GetNode()
+	node, err := network._GetNode()
+	require.NoError(t, err)
 	wg.Add(numThreads)
 	for i := 0; i < numThreads; i++ {
 		go func() {
 			for i := 0; i < 20; i++ {
-				network._GetNode()
+				_, _ = network._GetNode()
 				network._IncreaseBackoff(node)
 				node._IsHealthy()
 				node._GetAttempts()
@@ -141,7 +147,8 @@ func TestUnitConcurrentNodeGetChannel(t *testing.T) {
 
 	numThreads := 20
 	var wg sync.WaitGroup
-	node := network._GetNode()
+	node, err := network._GetNode()
+	require.NoError(t, err)
 	wg.Add(numThreads)
 	logger := NewLogger("", LoggerLevelError)
 	for i := 0; i < numThreads; i++ {
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -29,12 +30,17 @@ func (q *NetworkVersionInfoQuery) SetGrpcDeadline(deadline *time.Duration) *Netw
 }
 
 func (q *NetworkVersionInfoQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *NetworkVersionInfoQuery) Execute(client *Client) (NetworkVersionInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *NetworkVersionInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (NetworkVersionInfo, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return NetworkVersionInfo{}, err
@@ -91,6 +97,12 @@ func (q *NetworkVersionInfoQuery) SetLogLevel(level LogLevel) *NetworkVersionInf
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *NetworkVersionInfoQuery) SetRetryPolicy(policy RetryPolicy) *NetworkVersionInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *NetworkVersionInfoQuery) getMethod(channel *_Channel) _Method {
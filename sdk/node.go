@@ -178,7 +178,7 @@ func (node *_Node) _GetChannel(logger Logger) (*_Channel, error) {
 	// This information is used to gather usage metrics.
 	metadataOption := grpc.WithUnaryInterceptor(unaryInterceptor(metadata.Pairs(userAgent, getUserAgent())))
 
-	conn, err = grpc.NewClient(node._ManagedNode.address._String(), security, grpc.WithKeepaliveParams(kacp), metadataOption)
+	conn, err = grpc.NewClient(node._ManagedNode.address._String(), node.grpcOptions._Apply(security, grpc.WithKeepaliveParams(kacp), metadataOption)...)
 	if err != nil {
 		return nil, status.Error(codes.ResourceExhausted, "dial timeout of 10sec exceeded")
 	}
@@ -211,6 +211,7 @@ func (node *_Node) _ToSecure() _IManagedNode {
 		useCount:           node.useCount,
 		minBackoff:         node.minBackoff,
 		badGrpcStatusCount: node.badGrpcStatusCount,
+		grpcOptions:        node.grpcOptions,
 	}
 
 	return &_Node{
@@ -231,6 +232,7 @@ func (node *_Node) _ToInsecure() _IManagedNode {
 		useCount:           node.useCount,
 		minBackoff:         node.minBackoff,
 		badGrpcStatusCount: node.badGrpcStatusCount,
+		grpcOptions:        node.grpcOptions,
 	}
 
 	return &_Node{
@@ -261,7 +263,8 @@ func unaryInterceptor(md metadata.MD) grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		ctx = metadata.NewOutgoingContext(ctx, metadata.Join(metadata.MD{}, md))
+		existing, _ := metadata.FromOutgoingContext(ctx)
+		ctx = metadata.NewOutgoingContext(ctx, metadata.Join(existing, md))
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
@@ -16,6 +16,8 @@ type NodeAddress struct {
 	CertHash    []byte
 	Addresses   []Endpoint
 	Description string
+	// Stake is the stake of the node in tinybars, used by the StakeWeightedNodeSelector
+	Stake int64
 }
 
 func _NodeAddressFromProtobuf(nodeAd *services.NodeAddress) NodeAddress {
@@ -32,6 +34,7 @@ func _NodeAddressFromProtobuf(nodeAd *services.NodeAddress) NodeAddress {
 		CertHash:    nodeAd.GetNodeCertHash(),
 		Addresses:   address,
 		Description: nodeAd.GetDescription(),
+		Stake:       nodeAd.GetStake(), //nolint:staticcheck // the stake is deprecated in the address book but still served
 	}
 }
 
@@ -43,6 +46,7 @@ func (nodeAdd *NodeAddress) _ToProtobuf() *services.NodeAddress {
 		NodeCertHash:    nodeAdd.CertHash,
 		ServiceEndpoint: nil,
 		Description:     nodeAdd.Description,
+		Stake:           nodeAdd.Stake, //nolint:staticcheck
 	}
 
 	if nodeAdd.AccountID != nil {
@@ -0,0 +1,40 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"time"
+)
+
+// NodeHealth is a snapshot of what the client knows of the health of a consensus node, returned by
+// Client.GetNodeHealth, or of a mirror node, returned by Client.GetMirrorNodeHealth
+type NodeHealth struct {
+	// AccountID is the account of a consensus node, and empty for a mirror node
+	AccountID AccountID
+	Address   string
+	// Healthy is false while the node is backed off after failed calls, or quarantined, until its readmit time
+	Healthy bool
+	// CurrentBackoff is how long the node is taken out of use after its next failed call
+	CurrentBackoff time.Duration
+	// ReadmitTime is when the node is used again after it was backed off or quarantined, nil when it never was
+	ReadmitTime *time.Time
+	// ErrorCount is how many calls to the node failed with a gRPC error or a status backing it off, or for a mirror
+	// node how many streams and REST requests failed
+	ErrorCount int64
+	UseCount   int64
+	LastUsed   time.Time
+	// AverageLatency is the exponentially weighted moving average of the gRPC round trips to the node, or of the
+	// REST round trips to a mirror node, 0 before its first call
+	AverageLatency time.Duration
+	// InFlight is how many gRPC calls, streams included, or REST requests to the node are in flight
+	InFlight int64
+}
+
+// PingResult is the result of pinging a consensus node with Client.PingAllWithResults
+type PingResult struct {
+	NodeAccountID AccountID
+	// RoundTrip is how long the ping took, including the time to connect to the node
+	RoundTrip time.Duration
+	// Err is why the ping failed, nil when the node answered
+	Err error
+}
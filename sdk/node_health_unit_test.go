@@ -0,0 +1,142 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _BalanceResponse(code services.ResponseCodeEnum) *services.Response {
+	return &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: code, ResponseType: services.ResponseType_ANSWER_ONLY},
+				AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1800}},
+				Balance:   2000,
+			},
+		},
+	}
+}
+
+func TestUnitClientGetNodeHealth(t *testing.T) {
+	t.Parallel()
+
+	client, server := NewMockClientAndServer([][]interface{}{{_BalanceResponse(services.ResponseCodeEnum_OK)}, {}})
+	defer server.Close()
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.NoError(t, err)
+
+	health := client.GetNodeHealth()
+	require.Len(t, health, 2)
+	assert.Equal(t, AccountID{Account: 3}, health[0].AccountID)
+	assert.Equal(t, AccountID{Account: 4}, health[1].AccountID)
+
+	assert.True(t, health[0].Healthy)
+	assert.NotEmpty(t, health[0].Address)
+	assert.Equal(t, int64(1), health[0].UseCount)
+	assert.Greater(t, health[0].AverageLatency, time.Duration(0))
+	assert.Zero(t, health[0].ErrorCount)
+	assert.Zero(t, health[0].InFlight)
+	assert.Nil(t, health[0].ReadmitTime)
+
+	assert.True(t, health[1].Healthy)
+	assert.Zero(t, health[1].UseCount)
+	assert.Zero(t, health[1].AverageLatency)
+}
+
+func TestUnitClientQuarantineAndReadmitNode(t *testing.T) {
+	t.Parallel()
+
+	client, err := ClientForNetworkV2(map[string]AccountID{
+		"127.0.0.1:50211": {Account: 3},
+		"127.0.0.1:50212": {Account: 4},
+	})
+	require.NoError(t, err)
+	defer client.Close()
+	client.SetMaxNodesPerTransaction(2)
+
+	require.NoError(t, client.QuarantineNode(AccountID{Account: 3}, time.Hour))
+
+	health := client.GetNodeHealth()
+	require.Len(t, health, 2)
+	assert.False(t, health[0].Healthy)
+	require.NotNil(t, health[0].ReadmitTime)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *health[0].ReadmitTime, time.Minute)
+	assert.True(t, health[1].Healthy)
+
+	nodeAccountIDs, err := client.network._GetNodeAccountIDsForExecute()
+	require.NoError(t, err)
+	assert.Equal(t, []AccountID{{Account: 4}}, nodeAccountIDs)
+
+	require.NoError(t, client.ReadmitNode(AccountID{Account: 3}))
+	assert.True(t, client.GetNodeHealth()[0].Healthy)
+
+	nodeAccountIDs, err = client.network._GetNodeAccountIDsForExecute()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []AccountID{{Account: 3}, {Account: 4}}, nodeAccountIDs)
+
+	// Readmitting a healthy node leaves it in the healthy set once
+	require.NoError(t, client.ReadmitNode(AccountID{Account: 3}))
+	assert.Len(t, client.network.healthyNodes, 2)
+
+	err = client.QuarantineNode(AccountID{Account: 99}, time.Hour)
+	require.ErrorAs(t, err, &ErrUnknownNode{})
+	err = client.ReadmitNode(AccountID{Account: 99})
+	require.ErrorAs(t, err, &ErrUnknownNode{})
+	require.Error(t, client.QuarantineNode(AccountID{Account: 3}, 0))
+}
+
+func TestUnitClientQuarantineNodeIsReadmittedAfterDuration(t *testing.T) {
+	t.Parallel()
+
+	client, err := ClientForNetworkV2(map[string]AccountID{
+		"127.0.0.1:50211": {Account: 3},
+		"127.0.0.1:50212": {Account: 4},
+	})
+	require.NoError(t, err)
+	defer client.Close()
+	client.SetNodeMinReadmitPeriod(0)
+
+	require.NoError(t, client.QuarantineNode(AccountID{Account: 3}, 50*time.Millisecond))
+	client.network._ReadmitNodes()
+	assert.Len(t, client.network.healthyNodes, 1)
+
+	time.Sleep(100 * time.Millisecond)
+	client.network._ReadmitNodes()
+	assert.Len(t, client.network.healthyNodes, 2)
+}
+
+func TestUnitClientPingAllWithResults(t *testing.T) {
+	t.Parallel()
+
+	client, server := NewMockClientAndServer([][]interface{}{
+		{_BalanceResponse(services.ResponseCodeEnum_OK)},
+		{_BalanceResponse(services.ResponseCodeEnum_INVALID_ACCOUNT_ID)},
+	})
+	defer server.Close()
+
+	results := client.PingAllWithResults(context.Background())
+	require.Len(t, results, 2)
+
+	assert.Equal(t, AccountID{Account: 3}, results[0].NodeAccountID)
+	assert.NoError(t, results[0].Err)
+	assert.Greater(t, results[0].RoundTrip, time.Duration(0))
+
+	assert.Equal(t, AccountID{Account: 4}, results[1].NodeAccountID)
+	var precheckErr ErrHederaPreCheckStatus
+	require.ErrorAs(t, results[1].Err, &precheckErr)
+	assert.Equal(t, StatusInvalidAccountID, precheckErr.Status)
+}
@@ -0,0 +1,205 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// NodeCandidate is a healthy node a NodeSelector can choose, with what the client observed of it
+type NodeCandidate struct {
+	AccountID AccountID
+	Address   string
+	// Latency is the exponentially weighted moving average of the gRPC round trips to the node, 0 before its
+	// first call
+	Latency time.Duration
+	// InFlight is how many gRPC calls to the node are in flight
+	InFlight int64
+	// Stake is the stake of the node in the address book of the client, 0 when it is unknown
+	Stake int64
+}
+
+// NodeSelector chooses the nodes transactions and queries are sent to, among the healthy nodes of the network.
+// SelectNodes returns count of the candidates, in the order they are tried, or all of them when there are fewer.
+// It is set on the Client with SetNodeSelector, is shared by concurrent executions and must be safe for
+// concurrent use.
+type NodeSelector interface {
+	SelectNodes(candidates []NodeCandidate, count int) []NodeCandidate
+}
+
+// NodeSelectorFunc adapts a function to a NodeSelector.
+type NodeSelectorFunc func(candidates []NodeCandidate, count int) []NodeCandidate
+
+// SelectNodes calls f.
+func (f NodeSelectorFunc) SelectNodes(candidates []NodeCandidate, count int) []NodeCandidate {
+	return f(candidates, count)
+}
+
+// _NodeSelectorRandom guards the source of randomness of the node selectors
+type _NodeSelectorRandom struct {
+	mu     sync.Mutex
+	random *rand.Rand
+}
+
+func _NewNodeSelectorRandom() *_NodeSelectorRandom {
+	return &_NodeSelectorRandom{random: rand.New(rand.NewSource(time.Now().UnixNano()))} // #nosec
+}
+
+func (r *_NodeSelectorRandom) _Shuffle(candidates []NodeCandidate) []NodeCandidate {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	shuffled := append([]NodeCandidate{}, candidates...)
+	r.random.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+func (r *_NodeSelectorRandom) _Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.random.Float64()
+}
+
+func _FirstNodeCandidates(candidates []NodeCandidate, count int) []NodeCandidate {
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	return candidates[:count]
+}
+
+// RandomNodeSelector chooses healthy nodes at random, the default of the Client
+type RandomNodeSelector struct {
+	random *_NodeSelectorRandom
+}
+
+// NewRandomNodeSelector creates a RandomNodeSelector
+func NewRandomNodeSelector() *RandomNodeSelector {
+	return &RandomNodeSelector{random: _NewNodeSelectorRandom()}
+}
+
+// SelectNodes implements NodeSelector
+func (selector *RandomNodeSelector) SelectNodes(candidates []NodeCandidate, count int) []NodeCandidate {
+	return _FirstNodeCandidates(selector.random._Shuffle(candidates), count)
+}
+
+// RoundRobinNodeSelector chooses healthy nodes in turn, ordered by their account IDs
+type RoundRobinNodeSelector struct {
+	mu   sync.Mutex
+	next int
+}
+
+// NewRoundRobinNodeSelector creates a RoundRobinNodeSelector
+func NewRoundRobinNodeSelector() *RoundRobinNodeSelector {
+	return &RoundRobinNodeSelector{}
+}
+
+// SelectNodes implements NodeSelector
+func (selector *RoundRobinNodeSelector) SelectNodes(candidates []NodeCandidate, count int) []NodeCandidate {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sorted := append([]NodeCandidate{}, candidates...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].AccountID.Compare(sorted[j].AccountID) < 0
+	})
+
+	selector.mu.Lock()
+	start := selector.next % len(sorted)
+	selector.next = start + 1
+	selector.mu.Unlock()
+
+	return _FirstNodeCandidates(append(sorted[start:], sorted[:start]...), count)
+}
+
+// LeastLatencyNodeSelector chooses the healthy nodes with the lowest average gRPC round trip. Nodes which were
+// not called yet come first, so their latency gets known, and nodes with the same latency are chosen at random.
+type LeastLatencyNodeSelector struct {
+	random *_NodeSelectorRandom
+}
+
+// NewLeastLatencyNodeSelector creates a LeastLatencyNodeSelector
+func NewLeastLatencyNodeSelector() *LeastLatencyNodeSelector {
+	return &LeastLatencyNodeSelector{random: _NewNodeSelectorRandom()}
+}
+
+// SelectNodes implements NodeSelector
+func (selector *LeastLatencyNodeSelector) SelectNodes(candidates []NodeCandidate, count int) []NodeCandidate {
+	shuffled := selector.random._Shuffle(candidates)
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		return shuffled[i].Latency < shuffled[j].Latency
+	})
+
+	return _FirstNodeCandidates(shuffled, count)
+}
+
+// LeastInFlightNodeSelector chooses the healthy nodes with the fewest gRPC calls in flight, and nodes with as many
+// calls in flight at random
+type LeastInFlightNodeSelector struct {
+	random *_NodeSelectorRandom
+}
+
+// NewLeastInFlightNodeSelector creates a LeastInFlightNodeSelector
+func NewLeastInFlightNodeSelector() *LeastInFlightNodeSelector {
+	return &LeastInFlightNodeSelector{random: _NewNodeSelectorRandom()}
+}
+
+// SelectNodes implements NodeSelector
+func (selector *LeastInFlightNodeSelector) SelectNodes(candidates []NodeCandidate, count int) []NodeCandidate {
+	shuffled := selector.random._Shuffle(candidates)
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		return shuffled[i].InFlight < shuffled[j].InFlight
+	})
+
+	return _FirstNodeCandidates(shuffled, count)
+}
+
+// StakeWeightedNodeSelector chooses healthy nodes at random, weighted by their stake in the address book of the
+// client. Nodes without a known stake are chosen after the staked ones, and at random when no stake is known.
+type StakeWeightedNodeSelector struct {
+	random *_NodeSelectorRandom
+}
+
+// NewStakeWeightedNodeSelector creates a StakeWeightedNodeSelector
+func NewStakeWeightedNodeSelector() *StakeWeightedNodeSelector {
+	return &StakeWeightedNodeSelector{random: _NewNodeSelectorRandom()}
+}
+
+// SelectNodes implements NodeSelector
+func (selector *StakeWeightedNodeSelector) SelectNodes(candidates []NodeCandidate, count int) []NodeCandidate {
+	// Weighted sampling without replacement, ordering the nodes by u^(1/stake) for a uniform random u. The keys are
+	// compared as log(u)/stake, since u^(1/stake) rounds to 1 for stakes in tinybars.
+	keys := make(map[int]float64, len(candidates))
+	staked := make([]int, 0, len(candidates))
+	unstaked := make([]NodeCandidate, 0)
+	for i, candidate := range candidates {
+		if candidate.Stake > 0 {
+			// 1-u is in (0, 1], so its logarithm is finite
+			keys[i] = math.Log(1-selector.random._Float64()) / float64(candidate.Stake)
+			staked = append(staked, i)
+		} else {
+			unstaked = append(unstaked, candidate)
+		}
+	}
+
+	sort.Slice(staked, func(i, j int) bool {
+		return keys[staked[i]] > keys[staked[j]]
+	})
+
+	selected := make([]NodeCandidate, 0, len(candidates))
+	for _, index := range staked {
+		selected = append(selected, candidates[index])
+	}
+	// The nodes without a stake come after the staked ones, at random
+	selected = append(selected, selector.random._Shuffle(unstaked)...)
+
+	return _FirstNodeCandidates(selected, count)
+}
@@ -0,0 +1,230 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+)
+
+func _NodeCandidateAccounts(candidates []NodeCandidate) []uint64 {
+	accounts := make([]uint64, 0, len(candidates))
+	for _, candidate := range candidates {
+		accounts = append(accounts, candidate.AccountID.Account)
+	}
+	return accounts
+}
+
+func TestUnitRandomNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	candidates := []NodeCandidate{{AccountID: AccountID{Account: 3}}, {AccountID: AccountID{Account: 4}}, {AccountID: AccountID{Account: 5}}}
+
+	selected := NewRandomNodeSelector().SelectNodes(candidates, 2)
+	require.Len(t, selected, 2)
+	require.NotEqual(t, selected[0].AccountID, selected[1].AccountID)
+
+	require.ElementsMatch(t, []uint64{3, 4, 5}, _NodeCandidateAccounts(NewRandomNodeSelector().SelectNodes(candidates, 10)))
+	require.Empty(t, NewRandomNodeSelector().SelectNodes(nil, 1))
+}
+
+func TestUnitRoundRobinNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	candidates := []NodeCandidate{{AccountID: AccountID{Account: 5}}, {AccountID: AccountID{Account: 3}}, {AccountID: AccountID{Account: 4}}}
+	selector := NewRoundRobinNodeSelector()
+
+	require.Equal(t, []uint64{3, 4}, _NodeCandidateAccounts(selector.SelectNodes(candidates, 2)))
+	require.Equal(t, []uint64{4, 5}, _NodeCandidateAccounts(selector.SelectNodes(candidates, 2)))
+	require.Equal(t, []uint64{5, 3, 4}, _NodeCandidateAccounts(selector.SelectNodes(candidates, 3)))
+	require.Equal(t, []uint64{3}, _NodeCandidateAccounts(selector.SelectNodes(candidates, 1)))
+
+	// The candidates of the caller are left in their order
+	require.Equal(t, []uint64{5, 3, 4}, _NodeCandidateAccounts(candidates))
+	require.Empty(t, selector.SelectNodes(nil, 1))
+}
+
+func TestUnitLeastLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	candidates := []NodeCandidate{
+		{AccountID: AccountID{Account: 3}, Latency: 30 * time.Millisecond},
+		{AccountID: AccountID{Account: 4}, Latency: 10 * time.Millisecond},
+		{AccountID: AccountID{Account: 5}},
+		{AccountID: AccountID{Account: 6}, Latency: 20 * time.Millisecond},
+	}
+
+	// The node which was not called yet comes first
+	require.Equal(t, []uint64{5, 4, 6, 3}, _NodeCandidateAccounts(NewLeastLatencyNodeSelector().SelectNodes(candidates, 4)))
+	require.Equal(t, []uint64{5, 4}, _NodeCandidateAccounts(NewLeastLatencyNodeSelector().SelectNodes(candidates, 2)))
+}
+
+func TestUnitLeastInFlightNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	candidates := []NodeCandidate{
+		{AccountID: AccountID{Account: 3}, InFlight: 2},
+		{AccountID: AccountID{Account: 4}, InFlight: 0},
+		{AccountID: AccountID{Account: 5}, InFlight: 5},
+	}
+
+	require.Equal(t, []uint64{4, 3, 5}, _NodeCandidateAccounts(NewLeastInFlightNodeSelector().SelectNodes(candidates, 3)))
+	require.Equal(t, []uint64{4}, _NodeCandidateAccounts(NewLeastInFlightNodeSelector().SelectNodes(candidates, 1)))
+}
+
+func TestUnitStakeWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	candidates := []NodeCandidate{
+		{AccountID: AccountID{Account: 3}, Stake: 1},
+		{AccountID: AccountID{Account: 4}, Stake: 1_000},
+		{AccountID: AccountID{Account: 5}},
+	}
+	selector := NewStakeWeightedNodeSelector()
+
+	heaviestFirst := 0
+	for i := 0; i < 200; i++ {
+		selected := selector.SelectNodes(candidates, 3)
+		require.Len(t, selected, 3)
+		// The node without a stake comes after the staked ones
+		require.Equal(t, uint64(5), selected[2].AccountID.Account)
+		if selected[0].AccountID.Account == 4 {
+			heaviestFirst++
+		}
+	}
+	require.Greater(t, heaviestFirst, 190)
+
+	// Without any stake the nodes are chosen at random
+	unstaked := []NodeCandidate{{AccountID: AccountID{Account: 3}}, {AccountID: AccountID{Account: 4}}}
+	require.ElementsMatch(t, []uint64{3, 4}, _NodeCandidateAccounts(selector.SelectNodes(unstaked, 2)))
+}
+
+func TestUnitStakeWeightedNodeSelectorTinybarStakes(t *testing.T) {
+	t.Parallel()
+
+	// Address book stakes are in tinybars
+	candidates := []NodeCandidate{
+		{AccountID: AccountID{Account: 3}, Stake: 1_000_000_000_000_000},
+		{AccountID: AccountID{Account: 4}, Stake: 45_000_000_000_000_000},
+		{AccountID: AccountID{Account: 5}, Stake: 1_000_000_000_000_000},
+	}
+	selector := NewStakeWeightedNodeSelector()
+
+	picks := map[uint64]int{}
+	for i := 0; i < 10_000; i++ {
+		picks[selector.SelectNodes(candidates, 1)[0].AccountID.Account]++
+	}
+
+	// Nodes 3 and 5 are each expected about 213 times, node 4 about 9574 times
+	require.InDelta(t, 213, picks[3], 90)
+	require.InDelta(t, 213, picks[5], 90)
+	require.InDelta(t, picks[3], picks[5], 120)
+	require.Greater(t, picks[4], 9_300)
+}
+
+func TestUnitNetworkNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	network := _NewNetwork()
+	err := network.SetNetwork(newNetworkMockNodes())
+	require.NoError(t, err)
+	network.addressBook = map[AccountID]NodeAddress{{Account: 6}: {Stake: 42}}
+
+	var seen []NodeCandidate
+	network._SetNodeSelector(NodeSelectorFunc(func(candidates []NodeCandidate, count int) []NodeCandidate {
+		seen = candidates
+		// Unknown and repeated nodes are ignored, and the missing nodes are topped up
+		return []NodeCandidate{{AccountID: AccountID{Account: 6}}, {AccountID: AccountID{Account: 99}}, {AccountID: AccountID{Account: 6}}}
+	}))
+
+	node, err := network._GetNode()
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 6}, node.accountID)
+	require.Len(t, seen, 5)
+	for _, candidate := range seen {
+		if candidate.AccountID.Account == 6 {
+			require.Equal(t, int64(42), candidate.Stake)
+		}
+	}
+
+	network._SetMaxNodesPerTransaction(3)
+	nodeAccountIDs, err := network._GetNodeAccountIDsForExecute()
+	require.NoError(t, err)
+	require.Len(t, nodeAccountIDs, 3)
+	require.Equal(t, AccountID{Account: 6}, nodeAccountIDs[0])
+	require.NotContains(t, nodeAccountIDs[1:], AccountID{Account: 6})
+
+	network._SetNodeSelector(nil)
+	require.IsType(t, &RandomNodeSelector{}, network._GetNodeSelector())
+}
+
+func TestUnitNetworkNoHealthyNodes(t *testing.T) {
+	t.Parallel()
+
+	network := _NewNetwork()
+	err := network.SetNetwork(newNetworkMockNodes())
+	require.NoError(t, err)
+
+	for _, node := range network.nodes {
+		network._IncreaseBackoff(node.(*_Node))
+	}
+	require.Empty(t, network.healthyNodes)
+
+	_, err = network._GetNode()
+	require.ErrorIs(t, err, errNoHealthyNodes)
+
+	_, err = network._GetNodeAccountIDsForExecute()
+	require.ErrorIs(t, err, errNoHealthyNodes)
+}
+
+func TestUnitManagedNodeLatencyAndInFlight(t *testing.T) {
+	t.Parallel()
+
+	node, err := _NewNode(AccountID{Account: 3}, "0.testnet.hedera.com:50211", 250*time.Millisecond)
+	require.NoError(t, err)
+
+	require.Zero(t, node._GetLatency())
+	node._RecordLatency(100 * time.Millisecond)
+	require.Equal(t, 100*time.Millisecond, node._GetLatency())
+	node._RecordLatency(200 * time.Millisecond)
+	require.Equal(t, 130*time.Millisecond, node._GetLatency())
+
+	node._StartRequest()
+	node._StartRequest()
+	require.Equal(t, int64(2), node._GetInFlight())
+	node._EndRequest()
+	require.Equal(t, int64(1), node._GetInFlight())
+}
+
+func TestUnitClientNodeSelectorRecordsLatency(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	selector := NewLeastLatencyNodeSelector()
+	require.Same(t, selector, client.SetNodeSelector(selector).GetNodeSelector())
+
+	_, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	node, ok := client.network._GetNodeForAccountID(AccountID{Account: 3})
+	require.True(t, ok)
+	require.Greater(t, node._GetLatency(), time.Duration(0))
+	require.Zero(t, node._GetInFlight())
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -83,7 +84,7 @@ func (q *Query) GetQueryPayment() Hbar {
 }
 
 // GetCost returns the fee that would be charged to get the requested information (if a cost was requested).
-func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
+func (q *Query) getCost(ctx context.Context, client *Client, e QueryInterface) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -96,14 +97,18 @@ func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
 	}
 	q.paymentTransactions = make([]*services.Transaction, 0)
 	if !q.nodeAccountIDs.locked {
-		q.SetNodeAccountIDs([]AccountID{client.network._GetNode().accountID})
+		node, err := client.network._GetNode()
+		if err != nil {
+			return Hbar{}, err
+		}
+		q.SetNodeAccountIDs([]AccountID{node.accountID})
 	}
 
 	q.pb = e.buildQuery()
 
 	q.pbHeader.ResponseType = services.ResponseType_COST_ANSWER
 	q.paymentTransactionIDs._Advance()
-	resp, err := _Execute(client, e)
+	resp, err := _Execute(ctx, client, e)
 
 	if err != nil {
 		return Hbar{}, err
@@ -179,7 +184,7 @@ func (q *Query) SetPaymentTransactionID(transactionID TransactionID) *Query {
 	return q
 }
 
-func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, error) {
+func (q *Query) execute(ctx context.Context, client *Client, e QueryInterface) (*services.Response, error) {
 	q.client = client
 	if client == nil {
 		return nil, errNoClientProvided
@@ -200,7 +205,7 @@ func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, e
 			cost = q.maxQueryPayment
 		}
 
-		actualCost, err := q.getCost(client, e)
+		actualCost, err := q.getCost(ctx, client, e)
 		if err != nil {
 			return nil, err
 		}
@@ -218,13 +223,17 @@ func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, e
 
 	q.paymentTransactions = make([]*services.Transaction, 0)
 	if !q.nodeAccountIDs.locked {
-		q.SetNodeAccountIDs([]AccountID{client.network._GetNode().accountID})
+		node, err := client.network._GetNode()
+		if err != nil {
+			return nil, err
+		}
+		q.SetNodeAccountIDs([]AccountID{node.accountID})
 	}
 
 	q.pb = e.buildQuery()
 	q.pbHeader.ResponseType = services.ResponseType_ANSWER_ONLY
 
-	resp, err := _Execute(client, e)
+	resp, err := _Execute(ctx, client, e)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,211 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// RetryContext describes a failed attempt of a transaction or query, handed to a RetryPolicy
+// to decide whether and how the execution continues.
+type RetryContext struct {
+	// Attempt is the number of the attempt that failed, starting at 1.
+	Attempt     int64
+	MaxAttempts int
+	// Elapsed is the time since the execution started.
+	Elapsed       time.Duration
+	NodeAccountID AccountID
+	// GrpcCode is the gRPC status code of the call, codes.OK when the node answered.
+	GrpcCode codes.Code
+	// Status is the status the node answered with, StatusOk when the gRPC call failed.
+	Status Status
+	// Err is the gRPC error, or the status error the execution fails with if it is not retried.
+	Err error
+	// Default is what the SDK does when no RetryPolicy is set.
+	Default RetryDecision
+}
+
+// RetryDecision is what a RetryPolicy decides after a failed attempt.
+type RetryDecision struct {
+	// Retry continues the execution with another attempt, within the max attempts.
+	Retry bool
+	// Backoff is how long to wait before the next attempt.
+	Backoff time.Duration
+	// SwitchNode marks the node unhealthy so this and later executions move away from it until
+	// its backoff expires. Attempts always rotate through the node account IDs of the request.
+	SwitchNode bool
+}
+
+// RetryPolicy decides whether a failed attempt of a transaction or query is retried, how long to
+// wait before the next attempt and whether to move away from the node. It is set on the Client with
+// SetRetryPolicy, or on a single transaction or query with their SetRetryPolicy, which takes
+// precedence. A RetryPolicy can be shared by concurrent executions and must be safe for concurrent use.
+type RetryPolicy interface {
+	Decide(RetryContext) RetryDecision
+}
+
+// RetryPolicyFunc adapts a function to a RetryPolicy.
+type RetryPolicyFunc func(RetryContext) RetryDecision
+
+// Decide calls f.
+func (f RetryPolicyFunc) Decide(retryContext RetryContext) RetryDecision {
+	return f(retryContext)
+}
+
+// ExponentialBackoffRetryPolicy retries whatever the SDK retries by default, waiting an
+// exponentially growing and jittered backoff between attempts.
+type ExponentialBackoffRetryPolicy struct {
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	retryOn    map[Status]bool
+
+	mu     sync.Mutex
+	random *rand.Rand
+}
+
+// NewExponentialBackoffRetryPolicy creates an ExponentialBackoffRetryPolicy whose backoff starts at
+// minBackoff, doubles on every attempt up to maxBackoff, and is then jittered down by up to half.
+func NewExponentialBackoffRetryPolicy(minBackoff time.Duration, maxBackoff time.Duration) *ExponentialBackoffRetryPolicy {
+	if minBackoff < 0 {
+		panic("minBackoff must be a positive duration")
+	} else if maxBackoff < minBackoff {
+		panic("maxBackoff must be greater than or equal to minBackoff")
+	}
+
+	return &ExponentialBackoffRetryPolicy{
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+		retryOn:    map[Status]bool{},
+		random:     rand.New(rand.NewSource(time.Now().UnixNano())), // #nosec
+	}
+}
+
+// AddRetryableStatus retries the status on top of the statuses the SDK retries by default.
+func (policy *ExponentialBackoffRetryPolicy) AddRetryableStatus(status Status) *ExponentialBackoffRetryPolicy {
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+
+	policy.retryOn[status] = true
+	return policy
+}
+
+// GetMinBackoff returns the backoff of the first retry, before jitter.
+func (policy *ExponentialBackoffRetryPolicy) GetMinBackoff() time.Duration {
+	return policy.minBackoff
+}
+
+// GetMaxBackoff returns the largest backoff, before jitter.
+func (policy *ExponentialBackoffRetryPolicy) GetMaxBackoff() time.Duration {
+	return policy.maxBackoff
+}
+
+// Decide implements RetryPolicy.
+func (policy *ExponentialBackoffRetryPolicy) Decide(retryContext RetryContext) RetryDecision {
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+
+	retry := retryContext.Default.Retry
+	if retryContext.GrpcCode == codes.OK && policy.retryOn[retryContext.Status] {
+		retry = true
+	}
+
+	if !retry {
+		return RetryDecision{}
+	}
+
+	backoff := policy.minBackoff
+	for i := int64(1); i < retryContext.Attempt && backoff < policy.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > policy.maxBackoff {
+		backoff = policy.maxBackoff
+	}
+
+	if half := int64(backoff / 2); half > 0 {
+		backoff -= time.Duration(policy.random.Int63n(half + 1))
+	}
+
+	return RetryDecision{
+		Retry:      true,
+		Backoff:    backoff,
+		SwitchNode: retryContext.Default.SwitchNode,
+	}
+}
+
+// RetryBudgetPolicy caps the retries of every execution sharing it to a budget that refills at a
+// fixed rate, so that a struggling network is not flooded with retries. The backoff and node
+// decisions of the retries within budget are delegated to another RetryPolicy.
+type RetryBudgetPolicy struct {
+	policy           RetryPolicy
+	retriesPerSecond float64
+	maxTokens        float64
+
+	mu         sync.Mutex
+	tokens     float64
+	lastRefill time.Time
+	now        func() time.Time
+}
+
+// NewRetryBudgetPolicy creates a RetryBudgetPolicy allowing bursts of up to maxRetries retries,
+// refilled at retriesPerSecond. A nil policy delegates to the SDK default decisions.
+func NewRetryBudgetPolicy(policy RetryPolicy, retriesPerSecond float64, maxRetries int) *RetryBudgetPolicy {
+	if retriesPerSecond < 0 {
+		panic("retriesPerSecond must not be negative")
+	} else if maxRetries < 0 {
+		panic("maxRetries must not be negative")
+	}
+
+	return &RetryBudgetPolicy{
+		policy:           policy,
+		retriesPerSecond: retriesPerSecond,
+		maxTokens:        float64(maxRetries),
+		tokens:           float64(maxRetries),
+		lastRefill:       time.Now(),
+		now:              time.Now,
+	}
+}
+
+// GetRemainingRetries returns how many retries the budget currently allows.
+func (policy *RetryBudgetPolicy) GetRemainingRetries() int {
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+
+	policy._Refill()
+	return int(policy.tokens)
+}
+
+// Decide implements RetryPolicy.
+func (policy *RetryBudgetPolicy) Decide(retryContext RetryContext) RetryDecision {
+	decision := retryContext.Default
+	if policy.policy != nil {
+		decision = policy.policy.Decide(retryContext)
+	}
+
+	if !decision.Retry {
+		return decision
+	}
+
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+
+	policy._Refill()
+	if policy.tokens < 1 {
+		return RetryDecision{SwitchNode: decision.SwitchNode}
+	}
+
+	policy.tokens--
+	return decision
+}
+
+func (policy *RetryBudgetPolicy) _Refill() {
+	now := policy.now()
+	policy.tokens += now.Sub(policy.lastRefill).Seconds() * policy.retriesPerSecond
+	if policy.tokens > policy.maxTokens {
+		policy.tokens = policy.maxTokens
+	}
+	policy.lastRefill = now
+}
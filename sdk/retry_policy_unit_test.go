@@ -0,0 +1,182 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type _RecordingRetryPolicy struct {
+	mu       sync.Mutex
+	contexts []RetryContext
+	decide   func(RetryContext) RetryDecision
+}
+
+func (policy *_RecordingRetryPolicy) Decide(retryContext RetryContext) RetryDecision {
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+
+	policy.contexts = append(policy.contexts, retryContext)
+	return policy.decide(retryContext)
+}
+
+func _RetryPolicyTestTransfer() *TransferTransaction {
+	return NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1))
+}
+
+func TestUnitRetryPolicyStopsRetryingStatus(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY},
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	policy := &_RecordingRetryPolicy{decide: func(RetryContext) RetryDecision { return RetryDecision{} }}
+	client.SetRetryPolicy(policy)
+	require.Equal(t, policy, client.GetRetryPolicy())
+
+	_, err := _RetryPolicyTestTransfer().Execute(client)
+	var precheckErr ErrHederaPreCheckStatus
+	require.True(t, errors.As(err, &precheckErr))
+	require.Equal(t, StatusBusy, precheckErr.Status)
+
+	require.Len(t, policy.contexts, 1)
+	retryContext := policy.contexts[0]
+	require.Equal(t, int64(1), retryContext.Attempt)
+	require.Equal(t, AccountID{Account: 3}, retryContext.NodeAccountID)
+	require.Equal(t, codes.OK, retryContext.GrpcCode)
+	require.Equal(t, StatusBusy, retryContext.Status)
+	require.True(t, retryContext.Default.Retry)
+	require.Equal(t, 250*time.Millisecond, retryContext.Default.Backoff)
+}
+
+func TestUnitRetryPolicyRetriesStatusAndOverridesClient(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE},
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	clientPolicy := &_RecordingRetryPolicy{decide: func(RetryContext) RetryDecision { return RetryDecision{} }}
+	client.SetRetryPolicy(clientPolicy)
+
+	policy := &_RecordingRetryPolicy{decide: func(retryContext RetryContext) RetryDecision {
+		return RetryDecision{Retry: retryContext.Status == StatusInsufficientPayerBalance, Backoff: time.Millisecond}
+	}}
+
+	_, err := _RetryPolicyTestTransfer().
+		SetRetryPolicy(policy).
+		Execute(client)
+	require.NoError(t, err)
+	require.Empty(t, clientPolicy.contexts)
+	require.Len(t, policy.contexts, 1)
+	require.False(t, policy.contexts[0].Default.Retry)
+}
+
+func TestUnitRetryPolicyGrpcError(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		status.New(codes.Unavailable, "node is UNAVAILABLE").Err(),
+		status.New(codes.PermissionDenied, "denied").Err(),
+		&services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					Balance: 7,
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	policy := &_RecordingRetryPolicy{decide: func(retryContext RetryContext) RetryDecision {
+		return RetryDecision{Retry: true, Backoff: time.Millisecond}
+	}}
+
+	balance, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetRetryPolicy(policy).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, HbarFromTinybar(7), balance.Hbars)
+
+	require.Len(t, policy.contexts, 2)
+	require.Equal(t, codes.Unavailable, policy.contexts[0].GrpcCode)
+	require.True(t, policy.contexts[0].Default.Retry)
+	require.True(t, policy.contexts[0].Default.SwitchNode)
+	require.Equal(t, codes.PermissionDenied, policy.contexts[1].GrpcCode)
+	require.False(t, policy.contexts[1].Default.Retry)
+	require.Equal(t, int64(2), policy.contexts[1].Attempt)
+}
+
+func TestUnitExponentialBackoffRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := NewExponentialBackoffRetryPolicy(100*time.Millisecond, time.Second).
+		AddRetryableStatus(StatusInsufficientPayerBalance)
+
+	for attempt, max := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		decision := policy.Decide(RetryContext{Attempt: int64(attempt + 1), Default: RetryDecision{Retry: true, SwitchNode: true}})
+		require.True(t, decision.Retry)
+		require.True(t, decision.SwitchNode)
+		require.LessOrEqual(t, decision.Backoff, max)
+		require.GreaterOrEqual(t, decision.Backoff, max/2)
+	}
+
+	require.False(t, policy.Decide(RetryContext{Attempt: 1, Status: StatusInvalidSignature}).Retry)
+	require.True(t, policy.Decide(RetryContext{Attempt: 1, Status: StatusInsufficientPayerBalance}).Retry)
+	require.False(t, policy.Decide(RetryContext{Attempt: 1, GrpcCode: codes.PermissionDenied, Status: StatusInsufficientPayerBalance}).Retry)
+}
+
+func TestUnitRetryBudgetPolicy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	policy := NewRetryBudgetPolicy(nil, 2, 3)
+	policy.now = func() time.Time { return now }
+	policy.lastRefill = now
+
+	retryable := RetryContext{Default: RetryDecision{Retry: true, Backoff: time.Second}}
+	for i := 0; i < 3; i++ {
+		decision := policy.Decide(retryable)
+		require.True(t, decision.Retry)
+		require.Equal(t, time.Second, decision.Backoff)
+	}
+	require.False(t, policy.Decide(retryable).Retry)
+	require.Equal(t, 0, policy.GetRemainingRetries())
+
+	// non retryable failures don't consume the budget
+	require.False(t, policy.Decide(RetryContext{}).Retry)
+
+	now = now.Add(time.Second)
+	require.Equal(t, 2, policy.GetRemainingRetries())
+
+	now = now.Add(time.Minute)
+	require.Equal(t, 3, policy.GetRemainingRetries())
+}
@@ -134,6 +134,9 @@ func (item *RLPItem) Read(bytes []byte) error {
 
 // decodeBytes decodes the bytes starting from the given index.
 func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
+	if *index >= len(bytes) {
+		return errRLPTruncated
+	}
 	prefix := bytes[*index]
 	(*index)++
 
@@ -147,6 +150,9 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 	// Short string case
 	if prefix < 0xB8 {
 		stringLength := int(prefix) - 0x80
+		if *index+stringLength > len(bytes) {
+			return errRLPTruncated
+		}
 		item.itemValue = bytes[*index : *index+stringLength]
 		item.itemType = VALUE_TYPE
 		*index += stringLength
@@ -155,11 +161,9 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 
 	// Long string case
 	if prefix < 0xC0 {
-		stringLengthLength := int(prefix) - 0xB7
-		stringLength := 0
-		for i := 0; i < stringLengthLength; i++ {
-			stringLength = (stringLength << 8) + int(bytes[*index])
-			(*index)++
+		stringLength, err := _ReadRLPLength(bytes, index, int(prefix)-0xB7)
+		if err != nil {
+			return err
 		}
 		item.itemValue = bytes[*index : *index+stringLength]
 		item.itemType = VALUE_TYPE
@@ -168,8 +172,11 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 	}
 
 	// Short list case
-	if prefix < 0xF7 {
+	if prefix <= 0xF7 {
 		listLength := int(prefix) - 0xC0
+		if *index+listLength > len(bytes) {
+			return errRLPTruncated
+		}
 		startIndex := *index
 		for *index < startIndex+listLength {
 			childItem := NewRLPItem(LIST_TYPE)
@@ -183,11 +190,9 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 	}
 
 	// Long list case
-	listLengthLength := int(prefix) - 0xF7
-	listLength := 0
-	for i := 0; i < listLengthLength; i++ {
-		listLength = (listLength << 8) + int(bytes[*index])
-		(*index)++
+	listLength, err := _ReadRLPLength(bytes, index, int(prefix)-0xF7)
+	if err != nil {
+		return err
 	}
 	startIndex := *index
 	for *index < startIndex+listLength {
@@ -200,3 +205,23 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 	item.itemType = LIST_TYPE
 	return nil
 }
+
+// _ReadRLPLength reads the length of a long string or list, encoded in lengthLength bytes, and checks the bytes
+// hold an item of that length
+func _ReadRLPLength(bytes []byte, index *int, lengthLength int) (int, error) {
+	if lengthLength > 8 || *index+lengthLength > len(bytes) {
+		return 0, errRLPTruncated
+	}
+
+	length := 0
+	for i := 0; i < lengthLength; i++ {
+		length = (length << 8) + int(bytes[*index])
+		(*index)++
+	}
+
+	if length < 0 || length > len(bytes)-*index {
+		return 0, errRLPTruncated
+	}
+
+	return length, nil
+}
@@ -116,3 +116,36 @@ func TestRLPItemDecodeList(t *testing.T) {
 		assert.Equal(t, expectedItem.itemValue, item.childItems[i].itemValue) // Compare item values
 	}
 }
+
+func TestRLPItemDecodeListOf55Bytes(t *testing.T) {
+	t.Parallel()
+
+	// A list whose payload is 55 bytes is still a short list, prefixed 0xF7
+	list := NewRLPItem(LIST_TYPE)
+	list.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(make([]byte, 54)))
+	encoded, err := list.Write()
+	require.NoError(t, err)
+	require.Equal(t, byte(0xF7), encoded[0])
+
+	decoded := NewRLPItem(LIST_TYPE)
+	require.NoError(t, decoded.Read(encoded))
+	require.Len(t, decoded.childItems, 1)
+	assert.Equal(t, make([]byte, 54), decoded.childItems[0].itemValue)
+}
+
+func TestRLPItemDecodeTruncated(t *testing.T) {
+	t.Parallel()
+
+	for _, encoded := range [][]byte{
+		{0x85, 'h', 'e'},
+		{0xB8},
+		{0xB8, 0x40, 1, 2},
+		{0xC3, 0x01},
+		{0xF8},
+		{0xF8, 0x40, 0x01},
+		{0xC2, 0x82, 0x01},
+	} {
+		item := NewRLPItem(VALUE_TYPE)
+		require.Error(t, item.Read(encoded), "%x", encoded)
+	}
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -44,12 +45,17 @@ func (q *ScheduleInfoQuery) GetScheduleID() ScheduleID {
 }
 
 func (q *ScheduleInfoQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *ScheduleInfoQuery) Execute(client *Client) (ScheduleInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *ScheduleInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (ScheduleInfo, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return ScheduleInfo{}, err
@@ -105,6 +111,12 @@ func (q *ScheduleInfoQuery) SetLogLevel(level LogLevel) *ScheduleInfoQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *ScheduleInfoQuery) SetRetryPolicy(policy RetryPolicy) *ScheduleInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *ScheduleInfoQuery) getMethod(channel *_Channel) _Method {
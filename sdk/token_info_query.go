@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -44,12 +45,17 @@ func (q *TokenInfoQuery) GetTokenID() TokenID {
 }
 
 func (q *TokenInfoQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the TopicInfoQuery using the provided client
 func (q *TokenInfoQuery) Execute(client *Client) (TokenInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *TokenInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (TokenInfo, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return TokenInfo{}, err
@@ -108,6 +114,12 @@ func (q *TokenInfoQuery) SetLogLevel(level LogLevel) *TokenInfoQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *TokenInfoQuery) SetRetryPolicy(policy RetryPolicy) *TokenInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *TokenInfoQuery) getMethod(channel *_Channel) _Method {
@@ -78,3 +78,44 @@ func TokenNftInfoFromBytes(data []byte) (TokenNftInfo, error) {
 
 	return _TokenNftInfoFromProtobuf(&pb), nil
 }
+
+func _TokenNftInfoFromMirrorNode(nft MirrorNodeNft, ledgerID *LedgerID) (TokenNftInfo, error) {
+	tokenID, err := TokenIDFromString(nft.TokenID)
+	if err != nil {
+		return TokenNftInfo{}, err
+	}
+
+	accountID := AccountID{}
+	if nft.AccountID != nil {
+		if accountID, err = AccountIDFromString(*nft.AccountID); err != nil {
+			return TokenNftInfo{}, err
+		}
+	}
+
+	spenderID := AccountID{}
+	if nft.Spender != nil {
+		if spenderID, err = AccountIDFromString(*nft.Spender); err != nil {
+			return TokenNftInfo{}, err
+		}
+	}
+
+	creationTime := time.Time{}
+	if nft.CreatedTimestamp != "" {
+		if creationTime, err = ParseMirrorNodeTimestamp(nft.CreatedTimestamp); err != nil {
+			return TokenNftInfo{}, err
+		}
+	}
+
+	info := TokenNftInfo{
+		NftID:        NftID{TokenID: tokenID, SerialNumber: nft.SerialNumber},
+		AccountID:    accountID,
+		CreationTime: creationTime,
+		Metadata:     nft.Metadata,
+		SpenderID:    spenderID,
+	}
+	if ledgerID != nil {
+		info.LedgerID = *ledgerID
+	}
+
+	return info, nil
+}
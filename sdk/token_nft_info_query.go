@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -105,12 +106,17 @@ func (q *TokenNftInfoQuery) ByAccountID(id AccountID) *TokenNftInfoQuery {
 }
 
 func (q *TokenNftInfoQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *TokenNftInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TokenNftInfo, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return []TokenNftInfo{}, err
@@ -169,6 +175,12 @@ func (q *TokenNftInfoQuery) SetLogLevel(level LogLevel) *TokenNftInfoQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *TokenNftInfoQuery) SetRetryPolicy(policy RetryPolicy) *TokenNftInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *TokenNftInfoQuery) getMethod(channel *_Channel) _Method {
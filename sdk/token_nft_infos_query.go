@@ -0,0 +1,145 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// The consensus nodes permanently disabled TokenGetNftInfos and TokenGetAccountNftInfos
+// (they answer NOT_SUPPORTED), so the range queries are answered by the mirror node.
+const _NftInfosMirrorNodePageSize = 100
+
+var errInvalidNftInfosRange = errors.New("`end` must be greater than `start`, or 0 to query until the last NFT")
+
+// TokenNftInfosQuery lists the NFTs of a non-fungible token collection in the
+// index range [start, end), ordered by serial number.
+type TokenNftInfosQuery struct {
+	tokenID *TokenID
+	start   int64
+	end     int64
+}
+
+// NewTokenNftInfosQuery creates a TokenNftInfosQuery which lists the NFTs of a
+// collection. By default every NFT of the collection is returned.
+func NewTokenNftInfosQuery() *TokenNftInfosQuery {
+	return &TokenNftInfosQuery{}
+}
+
+// SetTokenID sets the ID of the NFT collection to list.
+func (q *TokenNftInfosQuery) SetTokenID(tokenID TokenID) *TokenNftInfosQuery {
+	q.tokenID = &tokenID
+	return q
+}
+
+// GetTokenID returns the ID of the NFT collection to list.
+func (q *TokenNftInfosQuery) GetTokenID() TokenID {
+	if q.tokenID == nil {
+		return TokenID{}
+	}
+
+	return *q.tokenID
+}
+
+// SetStart sets the index (inclusive) of the first NFT to return.
+func (q *TokenNftInfosQuery) SetStart(start int64) *TokenNftInfosQuery {
+	q.start = start
+	return q
+}
+
+// GetStart returns the index (inclusive) of the first NFT to return.
+func (q *TokenNftInfosQuery) GetStart() int64 {
+	return q.start
+}
+
+// SetEnd sets the index (exclusive) of the last NFT to return. 0 returns every NFT after start.
+func (q *TokenNftInfosQuery) SetEnd(end int64) *TokenNftInfosQuery {
+	q.end = end
+	return q
+}
+
+// GetEnd returns the index (exclusive) of the last NFT to return.
+func (q *TokenNftInfosQuery) GetEnd() int64 {
+	return q.end
+}
+
+// Execute executes the query using the client's mirror node and returns the NFTs in the range.
+func (q *TokenNftInfosQuery) Execute(client *Client) ([]TokenNftInfo, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the query using the client's mirror node, stopping when ctx is done.
+func (q *TokenNftInfosQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TokenNftInfo, error) {
+	if client == nil {
+		return []TokenNftInfo{}, errNoClientProvided
+	}
+
+	if q.tokenID == nil {
+		return []TokenNftInfo{}, errors.New("tokenID must be set")
+	}
+
+	if client.autoValidateChecksums {
+		if err := q.tokenID.Validate(client); err != nil {
+			return []TokenNftInfo{}, err
+		}
+	}
+
+	return _NftInfosFromMirrorNode(ctx, client, q.start, q.end, func(params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeNft], error) {
+		return client.GetMirrorNodeClient().GetTokenNfts(ctx, *q.tokenID, params)
+	})
+}
+
+// _NftInfosFromMirrorNode walks the mirror node NFT pages in ascending order and
+// collects the NFTs whose index falls in [start, end).
+func _NftInfosFromMirrorNode(
+	ctx context.Context,
+	client *Client,
+	start int64,
+	end int64,
+	firstPage func(params *MirrorNodeQueryParams) (*MirrorNodePage[MirrorNodeNft], error),
+) ([]TokenNftInfo, error) {
+	if start < 0 || end < 0 || (end != 0 && end <= start) {
+		return []TokenNftInfo{}, fmt.Errorf("%w (start: %d, end: %d)", errInvalidNftInfosRange, start, end)
+	}
+
+	limit := int64(_NftInfosMirrorNodePageSize)
+	if end != 0 && end < limit {
+		limit = end
+	}
+
+	page, err := firstPage(NewMirrorNodeQueryParams().SetLimit(int(limit)).SetOrder(MirrorNodeOrderAsc))
+	if err != nil {
+		return []TokenNftInfo{}, err
+	}
+
+	ledgerID := client.GetLedgerID()
+	infos := make([]TokenNftInfo, 0)
+	index := int64(0)
+
+	for {
+		for _, nft := range page.Items {
+			if end != 0 && index >= end {
+				return infos, nil
+			}
+
+			if index >= start {
+				info, err := _TokenNftInfoFromMirrorNode(nft, ledgerID)
+				if err != nil {
+					return []TokenNftInfo{}, err
+				}
+				infos = append(infos, info)
+			}
+			index++
+		}
+
+		if !page.HasNext() || (end != 0 && index >= end) {
+			return infos, nil
+		}
+
+		if page, err = page.Next(ctx); err != nil {
+			return []TokenNftInfo{}, err
+		}
+	}
+}
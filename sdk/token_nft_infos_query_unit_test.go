@@ -0,0 +1,118 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// _NftInfosTestHandler serves `total` NFTs of token 0.0.5 in pages of `limit`, following the mirror node link format.
+func _NftInfosTestHandler(t *testing.T, path string, total int64) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, path, r.URL.Path)
+		require.Equal(t, "asc", r.URL.Query().Get("order"))
+
+		var limit, after int64
+		_, err := fmt.Sscan(r.URL.Query().Get("limit"), &limit)
+		require.NoError(t, err)
+		if serial := r.URL.Query().Get("serialnumber"); serial != "" {
+			_, err = fmt.Sscan(strings.TrimPrefix(serial, "gt:"), &after)
+			require.NoError(t, err)
+		}
+
+		nfts := make([]string, 0)
+		last := after
+		for serial := after + 1; serial <= total && serial <= after+limit; serial++ {
+			nfts = append(nfts, fmt.Sprintf(`{"token_id": "0.0.5", "serial_number": %d, "account_id": "0.0.1234", "spender": "0.0.99", "created_timestamp": "1700000000.%09d", "metadata": "AQI="}`, serial, serial))
+			last = serial
+		}
+
+		next := "null"
+		if last < total {
+			next = fmt.Sprintf(`"%s?limit=%d&order=asc&serialnumber=gt:%d"`, path, limit, last)
+		}
+
+		_, _ = io.WriteString(w, fmt.Sprintf(`{"nfts": [%s], "links": {"next": %s}}`, strings.Join(nfts, ","), next))
+	}
+}
+
+func TestUnitTokenNftInfosQueryRange(t *testing.T) {
+	t.Parallel()
+
+	client, _ := _NewMirrorNodeTestServer(t, _NftInfosTestHandler(t, "/api/v1/tokens/0.0.5/nfts", 250))
+	client.SetLedgerID(*NewLedgerIDTestnet())
+
+	infos, err := NewTokenNftInfosQuery().
+		SetTokenID(TokenID{Token: 5}).
+		SetStart(98).
+		SetEnd(203).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, infos, 105)
+	require.Equal(t, NftID{TokenID: TokenID{Token: 5}, SerialNumber: 99}, infos[0].NftID)
+	require.Equal(t, int64(203), infos[len(infos)-1].NftID.SerialNumber)
+	require.Equal(t, AccountID{Account: 1234}, infos[0].AccountID)
+	require.Equal(t, AccountID{Account: 99}, infos[0].SpenderID)
+	require.Equal(t, time.Unix(1700000000, 99), infos[0].CreationTime)
+	require.Equal(t, []byte{1, 2}, infos[0].Metadata)
+	require.Equal(t, *NewLedgerIDTestnet(), infos[0].LedgerID)
+
+	infos, err = NewTokenNftInfosQuery().
+		SetTokenID(TokenID{Token: 5}).
+		SetStart(240).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, infos, 10)
+}
+
+func TestUnitAccountNftInfosQuery(t *testing.T) {
+	t.Parallel()
+
+	client, _ := _NewMirrorNodeTestServer(t, _NftInfosTestHandler(t, "/api/v1/accounts/0.0.1234/nfts", 3))
+
+	query := NewAccountNftInfosQuery().
+		SetAccountID(AccountID{Account: 1234}).
+		SetEnd(2)
+	require.Equal(t, AccountID{Account: 1234}, query.GetAccountID())
+	require.Equal(t, int64(0), query.GetStart())
+	require.Equal(t, int64(2), query.GetEnd())
+
+	infos, err := query.Execute(client)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	require.Equal(t, int64(1), infos[0].NftID.SerialNumber)
+	require.Equal(t, int64(2), infos[1].NftID.SerialNumber)
+}
+
+func TestUnitNftInfosQueryValidation(t *testing.T) {
+	t.Parallel()
+
+	client, _ := _NewMirrorNodeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	})
+
+	_, err := NewTokenNftInfosQuery().Execute(client)
+	require.ErrorContains(t, err, "tokenID must be set")
+
+	_, err = NewAccountNftInfosQuery().Execute(client)
+	require.ErrorContains(t, err, "accountID must be set")
+
+	_, err = NewTokenNftInfosQuery().SetTokenID(TokenID{Token: 5}).SetStart(5).SetEnd(5).Execute(client)
+	require.ErrorIs(t, err, errInvalidNftInfosRange)
+
+	_, err = NewAccountNftInfosQuery().SetAccountID(AccountID{Account: 1}).SetStart(-1).Execute(client)
+	require.ErrorIs(t, err, errInvalidNftInfosRange)
+
+	_, err = NewTokenNftInfosQuery().SetTokenID(TokenID{Token: 5}).Execute(nil)
+	require.ErrorIs(t, err, errNoClientProvided)
+}
@@ -2,6 +2,8 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
+import "context"
+
 type TokenRejectFlow struct {
 	ownerID           *AccountID
 	tokenIDs          []TokenID
@@ -176,16 +178,23 @@ func (tx *TokenRejectFlow) _CreateTokenRejectTransaction(client *Client) (*Token
 	return tokenRejectTxn, nil
 }
 
+// Execute executes the flow with the provided client
 func (tx *TokenRejectFlow) Execute(client *Client) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the flow with the provided client, stopping at whichever step is running
+// when ctx is done.
+func (tx *TokenRejectFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	tokenRejectTxn, err := tx._CreateTokenRejectTransaction(client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	tokenRejectResponse, err := tokenRejectTxn.Execute(client)
+	tokenRejectResponse, err := tokenRejectTxn.ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	_, err = tokenRejectResponse.GetReceipt(client)
+	_, err = tokenRejectResponse.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
@@ -194,11 +203,11 @@ func (tx *TokenRejectFlow) Execute(client *Client) (TransactionResponse, error)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	tokenDissociateResponse, err := tokenDissociateTxn.Execute(client)
+	tokenDissociateResponse, err := tokenDissociateTxn.ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	_, err = tokenDissociateResponse.GetReceipt(client)
+	_, err = tokenDissociateResponse.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
@@ -591,9 +591,11 @@ func createTokenWithKeysAndUpdateTokenKeyHelper(t *testing.T, createKeyType KeyT
 
 func updateTokenKeysHelper(t *testing.T, tokenID TokenID, updateKeyType KeyType, client *Client, newKey Key, signerKey PrivateKey, verificationMode TokenKeyValidation) (TransactionResponse, error) {
 	privateKey, _ := newKey.(PrivateKey)
+	nodeAccountIDs, err := client.network._GetNodeAccountIDsForExecute()
+	require.NoError(t, err)
 	// Update the key
 	tx := NewTokenUpdateTransaction().
-		SetNodeAccountIDs(client.network._GetNodeAccountIDsForExecute()[0:1]).
+		SetNodeAccountIDs(nodeAccountIDs[0:1]).
 		SetTokenID(tokenID).
 		SetKeyVerificationMode(verificationMode)
 
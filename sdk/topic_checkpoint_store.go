@@ -0,0 +1,157 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// TopicCheckpoint is the position of a TopicMessageQuery subscription in a topic: every message up to and
+// including SequenceNumber, which reached consensus at ConsensusTimestamp, has been delivered. A subscription
+// starting from the checkpoint resumes right after ConsensusTimestamp.
+type TopicCheckpoint struct {
+	ConsensusTimestamp time.Time `json:"consensusTimestamp"`
+	SequenceNumber     uint64    `json:"sequenceNumber"`
+}
+
+// CheckpointStore persists the checkpoints of topic subscriptions. A TopicMessageQuery with a store starts
+// from the checkpoint of its topic and saves a new checkpoint after each delivered message.
+type CheckpointStore interface {
+	// LoadCheckpoint returns the checkpoint of the topic, or nil if there is none
+	LoadCheckpoint(topicID TopicID) (*TopicCheckpoint, error)
+	// SaveCheckpoint replaces the checkpoint of the topic
+	SaveCheckpoint(topicID TopicID, checkpoint TopicCheckpoint) error
+}
+
+// InMemoryCheckpointStore keeps checkpoints in memory, for subscriptions resubscribing within one process
+type InMemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[TopicID]TopicCheckpoint
+}
+
+// NewInMemoryCheckpointStore creates an empty InMemoryCheckpointStore
+func NewInMemoryCheckpointStore() *InMemoryCheckpointStore {
+	return &InMemoryCheckpointStore{checkpoints: make(map[TopicID]TopicCheckpoint)}
+}
+
+// LoadCheckpoint returns the checkpoint of the topic, or nil if there is none
+func (store *InMemoryCheckpointStore) LoadCheckpoint(topicID TopicID) (*TopicCheckpoint, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	checkpoint, ok := store.checkpoints[_TopicCheckpointKey(topicID)]
+	if !ok {
+		return nil, nil
+	}
+
+	return &checkpoint, nil
+}
+
+// SaveCheckpoint replaces the checkpoint of the topic
+func (store *InMemoryCheckpointStore) SaveCheckpoint(topicID TopicID, checkpoint TopicCheckpoint) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.checkpoints[_TopicCheckpointKey(topicID)] = checkpoint
+	return nil
+}
+
+// FileCheckpointStore keeps checkpoints in a JSON file, keyed by topic ID. Every save rewrites the file through a
+// temporary file and a rename, so a crash leaves either the previous or the new checkpoints.
+type FileCheckpointStore struct {
+	mu          sync.Mutex
+	path        string
+	checkpoints map[string]TopicCheckpoint
+}
+
+// NewFileCheckpointStore creates a FileCheckpointStore backed by the file at path, loading its checkpoints if the
+// file exists
+func NewFileCheckpointStore(path string) (*FileCheckpointStore, error) {
+	store := &FileCheckpointStore{path: path, checkpoints: make(map[string]TopicCheckpoint)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &store.checkpoints); err != nil {
+			return nil, err
+		}
+	}
+
+	return store, nil
+}
+
+// LoadCheckpoint returns the checkpoint of the topic, or nil if there is none
+func (store *FileCheckpointStore) LoadCheckpoint(topicID TopicID) (*TopicCheckpoint, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	checkpoint, ok := store.checkpoints[_TopicCheckpointKey(topicID).String()]
+	if !ok {
+		return nil, nil
+	}
+
+	return &checkpoint, nil
+}
+
+// SaveCheckpoint replaces the checkpoint of the topic and writes the file
+func (store *FileCheckpointStore) SaveCheckpoint(topicID TopicID, checkpoint TopicCheckpoint) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	key := _TopicCheckpointKey(topicID).String()
+	previous, existed := store.checkpoints[key]
+	store.checkpoints[key] = checkpoint
+
+	if err := store._Write(); err != nil {
+		if existed {
+			store.checkpoints[key] = previous
+		} else {
+			delete(store.checkpoints, key)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (store *FileCheckpointStore) _Write() error {
+	data, err := json.MarshalIndent(store.checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(store.path), filepath.Base(store.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), store.path)
+}
+
+// _TopicCheckpointKey drops the checksum of the topic ID, which does not identify the topic
+func _TopicCheckpointKey(topicID TopicID) TopicID {
+	return TopicID{Shard: topicID.Shard, Realm: topicID.Realm, Topic: topicID.Topic}
+}
@@ -0,0 +1,178 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/status"
+)
+
+type _FailingCheckpointStore struct {
+	*InMemoryCheckpointStore
+}
+
+func (store _FailingCheckpointStore) SaveCheckpoint(TopicID, TopicCheckpoint) error {
+	return errors.New("disk full")
+}
+
+func TestUnitFileCheckpointStoreReload(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	store, err := NewFileCheckpointStore(path)
+	require.NoError(t, err)
+
+	checkpoint, err := store.LoadCheckpoint(TopicID{Topic: 7})
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	saved := TopicCheckpoint{ConsensusTimestamp: time.Unix(105, 3).UTC(), SequenceNumber: 5}
+	require.NoError(t, store.SaveCheckpoint(TopicID{Topic: 7}, saved))
+	require.NoError(t, store.SaveCheckpoint(TopicID{Topic: 8}, TopicCheckpoint{ConsensusTimestamp: time.Unix(1, 0).UTC(), SequenceNumber: 1}))
+
+	reloaded, err := NewFileCheckpointStore(path)
+	require.NoError(t, err)
+
+	checksum := "abcde"
+	checkpoint, err = reloaded.LoadCheckpoint(TopicID{Topic: 7, checksum: &checksum})
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	assert.True(t, saved.ConsensusTimestamp.Equal(checkpoint.ConsensusTimestamp))
+	assert.Equal(t, saved.SequenceNumber, checkpoint.SequenceNumber)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestUnitFileCheckpointStoreInvalidFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+
+	_, err := NewFileCheckpointStore(path)
+	require.Error(t, err)
+}
+
+func TestUnitTopicMessageQueryResumesFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		// The mirror node repeats message 3 and skips message 5
+		for _, sequence := range []uint64{3, 4, 6} {
+			if err := stream.Send(_TopicResponse(sequence, nil)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	store := NewInMemoryCheckpointStore()
+	require.NoError(t, store.SaveCheckpoint(TopicID{Topic: 7}, TopicCheckpoint{ConsensusTimestamp: time.Unix(103, 0), SequenceNumber: 3}))
+
+	var received []uint64
+	var gaps []ErrTopicSequenceGap
+	completed := make(chan struct{})
+	_, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetStartTime(time.Unix(0, 0)).
+		SetCheckpointStore(store).
+		SetSequenceGapHandler(func(gap ErrTopicSequenceGap) { gaps = append(gaps, gap) }).
+		SetCompletionHandler(func() { close(completed) }).
+		SetErrorHandler(func(stat status.Status) { t.Errorf("unexpected error %v", stat.Code()) }).
+		Subscribe(client, func(message TopicMessage) {
+			received = append(received, message.SequenceNumber)
+		})
+	require.NoError(t, err)
+
+	select {
+	case <-completed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	require.Equal(t, []uint64{4, 6}, received)
+	require.Equal(t, []ErrTopicSequenceGap{{TopicID: TopicID{Topic: 7}, Expected: 5, Received: 6}}, gaps)
+
+	script.mu.Lock()
+	require.Equal(t, _TimeToProtobuf(time.Unix(103, 1)).String(), script.queries[0].ConsensusStartTime.String())
+	script.mu.Unlock()
+
+	checkpoint, err := store.LoadCheckpoint(TopicID{Topic: 7})
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	assert.Equal(t, uint64(6), checkpoint.SequenceNumber)
+	assert.True(t, time.Unix(106, 0).Equal(checkpoint.ConsensusTimestamp))
+}
+
+func TestUnitTopicMessageQueryCheckpointHoldsIncompleteChunks(t *testing.T) {
+	t.Parallel()
+
+	initialTransactionID := TransactionIDGenerate(AccountID{Account: 2})._ToProtobuf()
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		err := stream.Send(_TopicResponse(1, nil))
+		if err != nil {
+			return err
+		}
+		err = stream.Send(_TopicResponse(2, &services.ConsensusMessageChunkInfo{InitialTransactionID: initialTransactionID, Total: 2, Number: 1}))
+		if err != nil {
+			return err
+		}
+		return stream.Send(_TopicResponse(3, nil))
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	store := NewInMemoryCheckpointStore()
+	stream, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetCheckpointStore(store).
+		Stream(context.Background(), client)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 3}, _CollectTopicStream(t, stream))
+	require.NoError(t, stream.Err())
+
+	// The checkpoint stays right before the first chunk of the incomplete message
+	checkpoint, err := store.LoadCheckpoint(TopicID{Topic: 7})
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	assert.Equal(t, uint64(1), checkpoint.SequenceNumber)
+	assert.True(t, time.Unix(102, 0).Add(-1*time.Nanosecond).Equal(checkpoint.ConsensusTimestamp))
+}
+
+func TestUnitTopicMessageQueryCheckpointSaveErrorEndsSubscription(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		return _SendTopicResponses(stream, 3)
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	stream, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetCheckpointStore(_FailingCheckpointStore{NewInMemoryCheckpointStore()}).
+		Stream(context.Background(), client)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1}, _CollectTopicStream(t, stream))
+	require.ErrorContains(t, stream.Err(), "disk full")
+
+	script.mu.Lock()
+	defer script.mu.Unlock()
+	require.Equal(t, 1, script.calls)
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -46,12 +47,17 @@ func (q *TopicInfoQuery) GetTopicID() TopicID {
 }
 
 func (q *TopicInfoQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the TopicInfoQuery using the provided client
 func (q *TopicInfoQuery) Execute(client *Client) (TopicInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *TopicInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (TopicInfo, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		return TopicInfo{}, err
@@ -107,6 +113,12 @@ func (q *TopicInfoQuery) SetLogLevel(level LogLevel) *TopicInfoQuery {
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *TopicInfoQuery) SetRetryPolicy(policy RetryPolicy) *TopicInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *TopicInfoQuery) getMethod(channel *_Channel) _Method {
@@ -4,6 +4,8 @@ package hiero
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"math"
 	"regexp"
@@ -25,15 +27,27 @@ type TopicMessageQuery struct {
 	errorHandler      func(stat status.Status)
 	completionHandler func()
 	retryHandler      func(err error) bool
+	chunkErrorHandler func(err error)
+	gapHandler        func(err ErrTopicSequenceGap)
+	checkpointStore   CheckpointStore
 	attempt           uint64
 	maxAttempts       uint64
 	topicID           *TopicID
 	startTime         *time.Time
 	endTime           *time.Time
 	limit             uint64
-	mu                sync.Mutex
+	chunkTimeout      time.Duration
+
+	streamBufferSize     int
+	streamOverflowPolicy TopicStreamOverflowPolicy
+
+	mu sync.Mutex
 }
 
+// defaultChunkTimeout is how long the chunks of a message are kept waiting for the rest of the message.
+// The chunks of a message all reach consensus within their transaction valid duration, at most 3 minutes.
+const defaultChunkTimeout = 5 * time.Minute
+
 // NewTopicMessageQuery creates TopicMessageQuery which
 // listens to messages sent to the specific TopicID
 func NewTopicMessageQuery() *TopicMessageQuery {
@@ -42,6 +56,10 @@ func NewTopicMessageQuery() *TopicMessageQuery {
 		errorHandler:      _DefaultErrorHandler,
 		retryHandler:      _DefaultRetryHandler,
 		completionHandler: _DefaultCompletionHandler,
+		chunkErrorHandler: _DefaultChunkErrorHandler,
+		gapHandler:        _DefaultGapHandler,
+		chunkTimeout:      defaultChunkTimeout,
+		streamBufferSize:  defaultStreamBufferSize,
 	}
 }
 
@@ -132,6 +150,72 @@ func (query *TopicMessageQuery) SetRetryHandler(retryHandler func(err error) boo
 	return query
 }
 
+// SetChunkTimeout Sets how long the received chunks of a message wait for the remaining chunks. When the timeout
+// passes the chunks are dropped and reported to the chunk error handler as ErrIncompleteTopicMessage.
+// Zero keeps them until the subscription ends. Defaults to 5 minutes.
+func (query *TopicMessageQuery) SetChunkTimeout(chunkTimeout time.Duration) *TopicMessageQuery {
+	query.chunkTimeout = chunkTimeout
+	return query
+}
+
+// GetChunkTimeout returns how long the received chunks of a message wait for the remaining chunks
+func (query *TopicMessageQuery) GetChunkTimeout() time.Duration {
+	return query.chunkTimeout
+}
+
+// SetChunkErrorHandler Sets the handler for chunked messages which cannot be reassembled, because chunks
+// are missing after the chunk timeout or carry invalid chunk info
+func (query *TopicMessageQuery) SetChunkErrorHandler(chunkErrorHandler func(err error)) *TopicMessageQuery {
+	query.chunkErrorHandler = chunkErrorHandler
+	return query
+}
+
+// SetCheckpointStore Sets the store of the position of the subscription in the topic. Subscribe and Stream start
+// after the checkpoint of the topic, if the store has one later than the start time, and save a checkpoint after
+// each message delivered. A checkpoint never moves past the first chunk of an incomplete chunked message, so a
+// restarted subscription receives the message again; messages are delivered at least once. With Stream, a message
+// counts as delivered once it is on the channel, so Stream requires the TopicStreamOverflowBlock policy, and a
+// message still waiting for room on the channel when the stream ends is not checkpointed.
+func (query *TopicMessageQuery) SetCheckpointStore(store CheckpointStore) *TopicMessageQuery {
+	query.checkpointStore = store
+	return query
+}
+
+// GetCheckpointStore returns the store of the position of the subscription in the topic
+func (query *TopicMessageQuery) GetCheckpointStore() CheckpointStore {
+	return query.checkpointStore
+}
+
+// SetSequenceGapHandler Sets the handler called when the sequence numbers of the topic skip messages, which the
+// mirror node should never do, and the subscription continues after the gap
+func (query *TopicMessageQuery) SetSequenceGapHandler(gapHandler func(err ErrTopicSequenceGap)) *TopicMessageQuery {
+	query.gapHandler = gapHandler
+	return query
+}
+
+// SetStreamBufferSize Sets the number of messages the channel returned by Stream buffers. Defaults to 100.
+func (query *TopicMessageQuery) SetStreamBufferSize(bufferSize int) *TopicMessageQuery {
+	query.streamBufferSize = bufferSize
+	return query
+}
+
+// GetStreamBufferSize returns the number of messages the channel returned by Stream buffers
+func (query *TopicMessageQuery) GetStreamBufferSize() int {
+	return query.streamBufferSize
+}
+
+// SetStreamOverflowPolicy Sets what Stream does with a message when the buffer is full. Defaults to
+// TopicStreamOverflowBlock, the only policy allowed with a checkpoint store.
+func (query *TopicMessageQuery) SetStreamOverflowPolicy(policy TopicStreamOverflowPolicy) *TopicMessageQuery {
+	query.streamOverflowPolicy = policy
+	return query
+}
+
+// GetStreamOverflowPolicy returns what Stream does with a message when the buffer is full
+func (query *TopicMessageQuery) GetStreamOverflowPolicy() TopicStreamOverflowPolicy {
+	return query.streamOverflowPolicy
+}
+
 func (query *TopicMessageQuery) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -167,110 +251,483 @@ func (query *TopicMessageQuery) build() *mirror.ConsensusTopicQuery {
 	return body
 }
 
-// Subscribe subscribes to messages sent to the specific TopicID
+// Subscribe subscribes to messages sent to the specific TopicID. When the stream fails with an error the retry
+// handler accepts, the subscription moves to the next mirror node of the client and resumes after the last
+// message received. Handlers are called from a single goroutine, in order.
 func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessage)) (SubscriptionHandle, error) {
-	var once sync.Once
-	done := make(chan struct{})
-	handle := SubscriptionHandle{}
-
-	err := query.validateNetworkOnIDs(client)
+	subscription, err := query._NewSubscription(client)
 	if err != nil {
 		return SubscriptionHandle{}, err
 	}
 
-	pb := query.build()
+	subscription.onNext = func(message TopicMessage) bool {
+		onNext(message)
+		return true
+	}
+	subscription.onComplete = query.completionHandler
+	subscription.onError = func(err error) {
+		query.errorHandler(*status.Convert(err))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go subscription._Run(ctx)
+
+	return SubscriptionHandle{onUnsubscribe: cancel}, nil
+}
+
+func (query *TopicMessageQuery) _NewSubscription(client *Client) (*_TopicSubscription, error) {
+	if client == nil {
+		return nil, errNoClientProvided
+	}
 
-	messages := make(map[string][]*mirror.ConsensusTopicResponse)
+	err := query.validateNetworkOnIDs(client)
+	if err != nil {
+		return nil, err
+	}
 
 	mirrorNode, err := client.mirrorNetwork._GetNextMirrorNode()
 	if err != nil {
-		return handle, err
+		return nil, err
+	}
+
+	subscription := &_TopicSubscription{
+		query:          query,
+		pb:             query.build(),
+		network:        client.mirrorNetwork,
+		mirrorNode:     mirrorNode,
+		remaining:      query.limit,
+		chunks:         make(map[string]*_TopicChunkGroup),
+		observer:       client._GetExecutionObserver(),
+		subscriptionID: _NextExecutionID(),
+	}
+
+	if err = subscription._LoadCheckpoint(); err != nil {
+		return nil, err
 	}
 
-	channel, err := mirrorNode._GetConsensusServiceClient()
+	// Connecting to the first mirror node here reports a misconfigured mirror network to the caller
+	if _, err = subscription.mirrorNode._GetConsensusServiceClient(); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// _TopicSubscription is the state of one Subscribe or Stream call
+type _TopicSubscription struct {
+	query *TopicMessageQuery
+	// onNext delivers a message, reporting whether it was delivered
+	onNext     func(TopicMessage) bool
+	onComplete func()
+	onError    func(err error)
+	pb         *mirror.ConsensusTopicQuery
+	network    *_MirrorNetwork
+	mirrorNode *_MirrorNode
+	// ctx is the context the subscription runs under, reported with its events
+	ctx            context.Context
+	remaining      uint64
+	chunks         map[string]*_TopicChunkGroup
+	observer       ExecutionObserver
+	subscriptionID uint64
+
+	// position is the latest response handled, nextSequence the sequence number expected next and resumedAt the
+	// one expected when the current stream started, zero when unknown
+	position     TopicCheckpoint
+	nextSequence uint64
+	resumedAt    uint64
+	saved        TopicCheckpoint
+}
+
+// _TopicChunkGroup holds the chunks received so far of one chunked message
+type _TopicChunkGroup struct {
+	transactionID TransactionID
+	total         int32
+	chunks        map[int32]*mirror.ConsensusTopicResponse
+	expiresAt     time.Time
+	// first is the position right before the earliest chunk received
+	first TopicCheckpoint
+}
+
+// _CheckpointSaveError ends a subscription when its checkpoint cannot be saved, rather than delivering messages
+// a restart would not resume after
+type _CheckpointSaveError struct {
+	err error
+}
+
+func (e _CheckpointSaveError) Error() string {
+	return "failed to save topic checkpoint: " + e.err.Error()
+}
+
+func (e _CheckpointSaveError) Unwrap() error {
+	return e.err
+}
+
+type _TopicStreamResult struct {
+	resp *mirror.ConsensusTopicResponse
+	err  error
+}
+
+func (subscription *_TopicSubscription) _StreamEvent(eventType TopicStreamEventType) TopicStreamEvent {
+	return TopicStreamEvent{
+		SubscriptionID: subscription.subscriptionID,
+		Type:           eventType,
+		TopicID:        subscription.query.GetTopicID(),
+		Address:        subscription.mirrorNode._GetAddress(),
+		Attempt:        subscription.query.attempt,
+		Context:        subscription.ctx,
+	}
+}
+
+func (subscription *_TopicSubscription) _Run(ctx context.Context) {
+	subscription.ctx = ctx
+	query := subscription.query
+	query.mu.Lock()
+	defer query.mu.Unlock()
+
+	query.attempt = 0
+	ticker := time.NewTicker(_ChunkExpiryInterval(query.chunkTimeout))
+	defer ticker.Stop()
+
+	for {
+		err := subscription._Stream(ctx, ticker.C)
+
+		// Unsubscribe ends the subscription with a CANCELLED status
+		if ctx.Err() != nil {
+			subscription._Fail(status.FromContextError(ctx.Err()).Err())
+			return
+		}
+
+		if err == io.EOF || subscription._Finished() {
+			subscription.observer.OnTopicStream(subscription._StreamEvent(TopicStreamCompleted))
+			subscription.onComplete()
+			return
+		}
+
+		var checkpointErr _CheckpointSaveError
+		retry := !errors.As(err, &checkpointErr) && query.retryHandler(err)
+		if retry && _IsMirrorNodeFailure(err) {
+			// The stream failed because of the mirror node, which is backed off like a consensus node after a failed call
+			subscription.network._IncreaseBackoff(subscription.mirrorNode)
+		}
+		if !retry || query.attempt >= query.maxAttempts {
+			subscription._Fail(err)
+			return
+		}
+
+		delay := time.Duration(math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)) * time.Millisecond
+		event := subscription._StreamEvent(TopicStreamRetry)
+		event.Backoff = delay
+		event.Err = err
+		subscription.observer.OnTopicStream(event)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			subscription._Fail(status.FromContextError(ctx.Err()).Err())
+			return
+		}
+
+		subscription._ExpireChunks(time.Now())
+		if err := subscription._SaveCheckpoint(); err != nil {
+			subscription._Fail(err)
+			return
+		}
+
+		query.attempt++
+		subscription._NextMirrorNode()
+	}
+}
+
+// _Fail ends the subscription with the error, reported to the observer and the error handler
+func (subscription *_TopicSubscription) _Fail(err error) {
+	event := subscription._StreamEvent(TopicStreamError)
+	event.Err = err
+	subscription.observer.OnTopicStream(event)
+
+	subscription.onError(err)
+}
+
+// _NextMirrorNode moves to the mirror node to resume the subscription on, another one than the one which failed
+// when there is another
+func (subscription *_TopicSubscription) _NextMirrorNode() {
+	if node, err := subscription.network._GetNextMirrorNodeAfter(subscription.mirrorNode); err == nil {
+		subscription.mirrorNode = node
+	}
+}
+
+// _Stream subscribes to the current mirror node and handles its responses until the stream ends
+func (subscription *_TopicSubscription) _Stream(ctx context.Context, tick <-chan time.Time) error {
+	node := subscription.mirrorNode
+	channel, err := node._GetConsensusServiceClient()
 	if err != nil {
-		return handle, err
+		return status.Error(codes.Unavailable, err.Error())
 	}
 
-	go func() {
-		query.mu.Lock()
-		defer query.mu.Unlock()
-		var subClient mirror.ConsensusService_SubscribeTopicClient
-		var err error
+	node._InUse()
+	node._StartRequest()
+	defer node._EndRequest()
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
+	stream, err := (*channel).SubscribeTopic(streamCtx, subscription.pb)
+	if err != nil {
+		return err
+	}
+
+	subscription.resumedAt = subscription.nextSequence
+
+	subscription.observer.OnTopicStream(subscription._StreamEvent(TopicStreamSubscribed))
+
+	results := make(chan _TopicStreamResult)
+	go func() {
 		for {
+			resp, err := stream.Recv()
+			select {
+			case results <- _TopicStreamResult{resp: resp, err: err}:
+			case <-streamCtx.Done():
+				return
+			}
 			if err != nil {
-				handle.Unsubscribe()
-
-				if grpcErr, ok := status.FromError(err); ok { // nolint
-					if query.attempt < query.maxAttempts && query.retryHandler(err) {
-						subClient = nil
-
-						delay := math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)
-						time.Sleep(time.Duration(delay) * time.Millisecond)
-						query.attempt++
-					} else {
-						query.errorHandler(*grpcErr)
-						break
-					}
-				} else if err == io.EOF {
-					query.completionHandler()
-					break
-				} else {
-					panic(err)
-				}
+				return
 			}
+		}
+	}()
 
-			if subClient == nil {
-				ctx, cancel := context.WithCancel(context.TODO())
-				handle.onUnsubscribe = cancel
-				once.Do(func() {
-					close(done)
-				})
-				subClient, err = (*channel).SubscribeTopic(ctx, pb)
+	for {
+		select {
+		case result := <-results:
+			if result.err == nil || result.err == io.EOF {
+				node._DecreaseBackoff()
+			}
+			if result.err != nil {
+				return result.err
+			}
 
-				if err != nil {
-					continue
+			subscription.query.attempt = 0
+			if subscription._Handle(result.resp) {
+				if err := subscription._SaveCheckpoint(); err != nil {
+					return err
 				}
+			} else if ctx.Err() != nil {
+				// The message was not delivered as the subscription ended, so the checkpoint stays before it
+				return ctx.Err()
 			}
 
-			var resp *mirror.ConsensusTopicResponse
-			resp, err = subClient.Recv()
-
-			if err != nil {
-				continue
+			// The limit or end time is reached, without waiting for the mirror node to end the stream
+			if subscription._Finished() {
+				return io.EOF
 			}
-
-			if resp.ConsensusTimestamp != nil {
-				pb.ConsensusStartTime = _TimeToProtobuf(_TimeFromProtobuf(resp.ConsensusTimestamp).Add(1 * time.Nanosecond))
+		case now := <-tick:
+			subscription._ExpireChunks(now)
+			if err := subscription._SaveCheckpoint(); err != nil {
+				return err
 			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
 
-			if pb.Limit > 0 {
-				pb.Limit--
-			}
+// _Finished reports whether a new stream would not receive any message, because the limit is reached or the
+// last message received is at the end time
+func (subscription *_TopicSubscription) _Finished() bool {
+	if subscription.query.limit > 0 && subscription.remaining == 0 {
+		return true
+	}
 
-			if resp.ChunkInfo == nil || resp.ChunkInfo.Total == 1 {
-				onNext(_TopicMessageOfSingle(resp))
-			} else {
-				txID := _TransactionIDFromProtobuf(resp.ChunkInfo.InitialTransactionID).String()
-				message, ok := messages[txID]
-				if !ok {
-					message = make([]*mirror.ConsensusTopicResponse, 0, resp.ChunkInfo.Total)
-				}
+	endTime := subscription.pb.GetConsensusEndTime()
+	return endTime != nil && !_TimeFromProtobuf(subscription.pb.ConsensusStartTime).Before(_TimeFromProtobuf(endTime))
+}
 
-				message = append(message, resp)
-				messages[txID] = message
+// _Handle handles a response, reporting false when it completed a message which was not delivered, in which
+// case the position is held before the message
+func (subscription *_TopicSubscription) _Handle(resp *mirror.ConsensusTopicResponse) bool {
+	previous := subscription.position
 
-				if int32(len(message)) == resp.ChunkInfo.Total {
-					delete(messages, txID)
+	// A new stream resumes after the last message received
+	if resp.ConsensusTimestamp != nil {
+		subscription.pb.ConsensusStartTime = _TimeToProtobuf(_TimeFromProtobuf(resp.ConsensusTimestamp).Add(1 * time.Nanosecond))
+	}
 
-					onNext(_TopicMessageOfMany(message))
-				}
-			}
+	if resp.SequenceNumber != 0 && resp.SequenceNumber < subscription.resumedAt {
+		// Handled already, before the stream was resumed
+		return true
+	}
+
+	if subscription.remaining > 0 {
+		subscription.remaining--
+		subscription.pb.Limit = subscription.remaining
+	}
+
+	if resp.SequenceNumber >= subscription.nextSequence && resp.SequenceNumber != 0 {
+		if subscription.nextSequence != 0 && resp.SequenceNumber > subscription.nextSequence {
+			subscription.query.gapHandler(ErrTopicSequenceGap{
+				TopicID:  subscription.query.GetTopicID(),
+				Expected: subscription.nextSequence,
+				Received: resp.SequenceNumber,
+			})
 		}
-	}()
-	<-done
-	return handle, nil
+
+		subscription.nextSequence = resp.SequenceNumber + 1
+		subscription.position = TopicCheckpoint{
+			ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
+			SequenceNumber:     resp.SequenceNumber,
+		}
+	}
+
+	chunkInfo := resp.ChunkInfo
+	if chunkInfo == nil || chunkInfo.Total == 1 {
+		if !subscription._Deliver(_TopicMessageOfSingle(resp)) {
+			subscription.position = previous
+			return false
+		}
+		return true
+	}
+
+	if chunkInfo.InitialTransactionID == nil || chunkInfo.Number < 1 || chunkInfo.Number > chunkInfo.Total {
+		subscription.query.chunkErrorHandler(fmt.Errorf("topic message %d has invalid chunk info %d/%d", resp.SequenceNumber, chunkInfo.Number, chunkInfo.Total))
+		return true
+	}
+
+	transactionID := _TransactionIDFromProtobuf(chunkInfo.InitialTransactionID)
+	key := transactionID.String()
+	group, ok := subscription.chunks[key]
+	if !ok {
+		group = &_TopicChunkGroup{
+			transactionID: transactionID,
+			total:         chunkInfo.Total,
+			chunks:        make(map[int32]*mirror.ConsensusTopicResponse, chunkInfo.Total),
+			expiresAt:     time.Now().Add(subscription.query.chunkTimeout),
+		}
+		subscription.chunks[key] = group
+	}
+
+	if resp.SequenceNumber != 0 && (group.first.SequenceNumber == 0 || resp.SequenceNumber <= group.first.SequenceNumber) {
+		group.first = TopicCheckpoint{
+			ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp).Add(-1 * time.Nanosecond),
+			SequenceNumber:     resp.SequenceNumber - 1,
+		}
+	}
+
+	// A chunk received again replaces the previous copy
+	group.chunks[chunkInfo.Number] = resp
+	if int32(len(group.chunks)) < group.total {
+		return true
+	}
+
+	delete(subscription.chunks, key)
+
+	message := make([]*mirror.ConsensusTopicResponse, 0, group.total)
+	for number := int32(1); number <= group.total; number++ {
+		chunk, ok := group.chunks[number]
+		if !ok {
+			subscription.query.chunkErrorHandler(fmt.Errorf("topic message %v has chunks of different totals", transactionID))
+			return true
+		}
+		message = append(message, chunk)
+	}
+
+	if !subscription._Deliver(_TopicMessageOfMany(message)) {
+		subscription.position = group.first
+		return false
+	}
+
+	return true
+}
+
+// _ExpireChunks drops the chunked messages still incomplete after the chunk timeout
+func (subscription *_TopicSubscription) _ExpireChunks(now time.Time) {
+	if subscription.query.chunkTimeout <= 0 {
+		return
+	}
+
+	for key, group := range subscription.chunks {
+		if now.Before(group.expiresAt) {
+			continue
+		}
+
+		delete(subscription.chunks, key)
+		subscription.query.chunkErrorHandler(ErrIncompleteTopicMessage{
+			TransactionID:  group.transactionID,
+			ReceivedChunks: len(group.chunks),
+			TotalChunks:    int(group.total),
+		})
+	}
+}
+
+// _LoadCheckpoint starts the subscription after the checkpoint of the topic, unless the start time is later
+func (subscription *_TopicSubscription) _LoadCheckpoint() error {
+	store := subscription.query.checkpointStore
+	if store == nil {
+		return nil
+	}
+
+	checkpoint, err := store.LoadCheckpoint(subscription.query.GetTopicID())
+	if err != nil || checkpoint == nil {
+		return err
+	}
+
+	resumeTime := checkpoint.ConsensusTimestamp.Add(1 * time.Nanosecond)
+	if resumeTime.Before(_TimeFromProtobuf(subscription.pb.ConsensusStartTime)) {
+		return nil
+	}
+
+	subscription.pb.ConsensusStartTime = _TimeToProtobuf(resumeTime)
+	subscription.position = *checkpoint
+	subscription.saved = *checkpoint
+	subscription.nextSequence = checkpoint.SequenceNumber + 1
+	return nil
+}
+
+// _SaveCheckpoint saves the position of the subscription, held right before the earliest chunk of the incomplete
+// chunked messages, if it moved
+func (subscription *_TopicSubscription) _SaveCheckpoint() error {
+	store := subscription.query.checkpointStore
+	if store == nil || subscription.position.SequenceNumber == 0 {
+		return nil
+	}
+
+	checkpoint := subscription.position
+	for _, group := range subscription.chunks {
+		if group.first.SequenceNumber < checkpoint.SequenceNumber {
+			checkpoint = group.first
+		}
+	}
+
+	if checkpoint == subscription.saved {
+		return nil
+	}
+
+	if err := store.SaveCheckpoint(subscription.query.GetTopicID(), checkpoint); err != nil {
+		return _CheckpointSaveError{err: err}
+	}
+
+	subscription.saved = checkpoint
+	return nil
+}
+
+// _Deliver delivers the message, reporting whether it was delivered
+func (subscription *_TopicSubscription) _Deliver(message TopicMessage) bool {
+	event := subscription._StreamEvent(TopicStreamMessage)
+	event.SequenceNumber = message.SequenceNumber
+	event.ConsensusTimestamp = message.ConsensusTimestamp
+	subscription.observer.OnTopicStream(event)
+
+	return subscription.onNext(message)
+}
+
+// _ChunkExpiryInterval returns how often incomplete chunked messages are checked for expiry
+func _ChunkExpiryInterval(chunkTimeout time.Duration) time.Duration {
+	interval := chunkTimeout / 4
+	if interval <= 0 || interval > time.Second {
+		return time.Second
+	}
+	if interval < 10*time.Millisecond {
+		return 10 * time.Millisecond
+	}
+
+	return interval
 }
 
 func _DefaultErrorHandler(stat status.Status) {
@@ -281,6 +738,32 @@ func _DefaultCompletionHandler() {
 	println("Subscription to topic finished")
 }
 
+func _DefaultChunkErrorHandler(err error) {
+	println("Dropped topic message:", err.Error())
+}
+
+func _DefaultGapHandler(err ErrTopicSequenceGap) {
+	println("Missed topic messages:", err.Error())
+}
+
+// _IsMirrorNodeFailure reports whether the stream failed because of the mirror node serving it rather than the
+// query, such as a topic not found yet, so that the mirror node is backed off
+func _IsMirrorNodeFailure(err error) bool {
+	grpcErr, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	switch grpcErr.Code() {
+	case codes.ResourceExhausted, codes.Unavailable:
+		return true
+	case codes.Internal:
+		return rstStream.MatchString(grpcErr.Message())
+	default:
+		return false
+	}
+}
+
 func _DefaultRetryHandler(err error) bool {
 	code := status.Code(err)
 
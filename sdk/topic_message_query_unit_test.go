@@ -6,11 +6,18 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"errors"
+	"net"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
@@ -84,3 +91,292 @@ func TestUnitTopicMessageQueryNothingSet(t *testing.T) {
 	balance.GetEndTime()
 	balance.GetLimit()
 }
+
+// _ScriptedTopicServer answers each subscription with the script, given the number of the subscription
+// across all the servers sharing the script
+type _ScriptedTopicServer struct {
+	mirror.UnimplementedConsensusServiceServer
+	script *_TopicScript
+}
+
+type _TopicScript struct {
+	mu        sync.Mutex
+	calls     int
+	queries   []*mirror.ConsensusTopicQuery
+	subscribe func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error
+}
+
+func (server *_ScriptedTopicServer) SubscribeTopic(query *mirror.ConsensusTopicQuery, stream mirror.ConsensusService_SubscribeTopicServer) error {
+	server.script.mu.Lock()
+	call := server.script.calls
+	server.script.calls++
+	server.script.queries = append(server.script.queries, query)
+	server.script.mu.Unlock()
+
+	return server.script.subscribe(call, stream)
+}
+
+func _NewScriptedTopicClient(t *testing.T, script *_TopicScript, servers int) *Client {
+	addresses := make([]string, 0, servers)
+	for i := 0; i < servers; i++ {
+		listener, err := net.Listen("tcp", "localhost:0")
+		require.NoError(t, err)
+		grpcServer := grpc.NewServer()
+		mirror.RegisterConsensusServiceServer(grpcServer, &_ScriptedTopicServer{script: script})
+		go func() {
+			_ = grpcServer.Serve(listener)
+		}()
+		t.Cleanup(grpcServer.Stop)
+
+		addresses = append(addresses, listener.Addr().String())
+	}
+
+	client := ClientForNetwork(map[string]AccountID{})
+	t.Cleanup(func() { _ = client.Close() })
+	client.SetMirrorNetwork(addresses)
+
+	return client
+}
+
+func _TopicResponse(sequenceNumber uint64, chunkInfo *services.ConsensusMessageChunkInfo) *mirror.ConsensusTopicResponse {
+	return &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: &services.Timestamp{Seconds: int64(100 + sequenceNumber)},
+		Message:            []byte{byte(sequenceNumber)},
+		SequenceNumber:     sequenceNumber,
+		ChunkInfo:          chunkInfo,
+	}
+}
+
+func TestUnitTopicMessageQueryFailsOverToNextMirrorNode(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		if call == 0 {
+			if err := stream.Send(_TopicResponse(1, nil)); err != nil {
+				return err
+			}
+			return status.Error(codes.Unavailable, "mirror node is restarting")
+		}
+
+		for sequence := uint64(2); sequence <= 3; sequence++ {
+			if err := stream.Send(_TopicResponse(sequence, nil)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}}
+	client := _NewScriptedTopicClient(t, script, 2)
+
+	var received []uint64
+	completed := make(chan struct{})
+	_, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetLimit(5).
+		SetCompletionHandler(func() { close(completed) }).
+		SetErrorHandler(func(stat status.Status) { t.Errorf("unexpected error %v", stat.Code()) }).
+		Subscribe(client, func(message TopicMessage) {
+			received = append(received, message.SequenceNumber)
+		})
+	require.NoError(t, err)
+
+	select {
+	case <-completed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	require.Equal(t, []uint64{1, 2, 3}, received)
+
+	script.mu.Lock()
+	defer script.mu.Unlock()
+	require.Len(t, script.queries, 2)
+	require.Equal(t, _TimeToProtobuf(time.Unix(101, 1)).String(), script.queries[1].ConsensusStartTime.String())
+	require.Equal(t, uint64(4), script.queries[1].Limit)
+}
+
+func TestUnitTopicMessageQueryCompletesWhenLimitReachedBeforeError(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		if err := stream.Send(_TopicResponse(1, nil)); err != nil {
+			return err
+		}
+		return status.Error(codes.Unavailable, "mirror node is restarting")
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	completed := make(chan struct{})
+	_, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetLimit(1).
+		SetCompletionHandler(func() { close(completed) }).
+		Subscribe(client, func(TopicMessage) {})
+	require.NoError(t, err)
+
+	select {
+	case <-completed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	script.mu.Lock()
+	defer script.mu.Unlock()
+	require.Equal(t, 1, script.calls)
+}
+
+func TestUnitTopicMessageQueryReassemblesChunks(t *testing.T) {
+	t.Parallel()
+
+	initialTransactionID := TransactionIDGenerate(AccountID{Account: 2})._ToProtobuf()
+	chunk := func(number int32) *services.ConsensusMessageChunkInfo {
+		return &services.ConsensusMessageChunkInfo{InitialTransactionID: initialTransactionID, Total: 2, Number: number}
+	}
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		// The second chunk arrives first and the first chunk twice
+		for _, resp := range []*mirror.ConsensusTopicResponse{_TopicResponse(2, chunk(2)), _TopicResponse(1, chunk(1)), _TopicResponse(1, chunk(1))} {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	var received []TopicMessage
+	completed := make(chan struct{})
+	_, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetCompletionHandler(func() { close(completed) }).
+		Subscribe(client, func(message TopicMessage) {
+			received = append(received, message)
+		})
+	require.NoError(t, err)
+
+	select {
+	case <-completed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	require.Len(t, received, 1)
+	require.Equal(t, []byte{1, 2}, received[0].Contents)
+	require.Len(t, received[0].Chunks, 2)
+}
+
+func TestUnitTopicMessageQueryExpiresIncompleteChunks(t *testing.T) {
+	t.Parallel()
+
+	initialTransactionID := TransactionIDGenerate(AccountID{Account: 2})._ToProtobuf()
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		err := stream.Send(_TopicResponse(1, &services.ConsensusMessageChunkInfo{InitialTransactionID: initialTransactionID, Total: 3, Number: 1}))
+		if err != nil {
+			return err
+		}
+		err = stream.Send(_TopicResponse(2, &services.ConsensusMessageChunkInfo{InitialTransactionID: initialTransactionID, Total: 3, Number: 7}))
+		if err != nil {
+			return err
+		}
+
+		<-stream.Context().Done()
+		return nil
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	chunkErrors := make(chan error, 2)
+	handle, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetChunkTimeout(50*time.Millisecond).
+		SetChunkErrorHandler(func(err error) { chunkErrors <- err }).
+		SetErrorHandler(func(stat status.Status) {
+			if stat.Code() != codes.Canceled {
+				t.Errorf("unexpected error %v", stat.Code())
+			}
+		}).
+		Subscribe(client, func(TopicMessage) { t.Error("unexpected message") })
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-chunkErrors:
+			var incomplete ErrIncompleteTopicMessage
+			if errors.As(err, &incomplete) {
+				require.Equal(t, _TransactionIDFromProtobuf(initialTransactionID).String(), incomplete.TransactionID.String())
+				require.Equal(t, 1, incomplete.ReceivedChunks)
+				require.Equal(t, 3, incomplete.TotalChunks)
+			} else {
+				require.Contains(t, err.Error(), "invalid chunk info 7/3")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("incomplete message was not reported")
+		}
+	}
+}
+
+func TestUnitTopicMessageQueryUnsubscribeReportsCancelled(t *testing.T) {
+	t.Parallel()
+
+	subscribed := make(chan struct{})
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		close(subscribed)
+		<-stream.Context().Done()
+		return nil
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+	observer := &_RecordingExecutionObserver{}
+	client.SetExecutionObserver(observer)
+
+	failed := make(chan status.Status, 1)
+	handle, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetErrorHandler(func(stat status.Status) { failed <- stat }).
+		SetCompletionHandler(func() { t.Error("unexpected completion") }).
+		Subscribe(client, func(TopicMessage) {})
+	require.NoError(t, err)
+
+	<-subscribed
+	handle.Unsubscribe()
+
+	select {
+	case stat := <-failed:
+		require.Equal(t, codes.Canceled, stat.Code())
+	case <-time.After(5 * time.Second):
+		t.Fatal("unsubscribing was not reported")
+	}
+
+	// The observer sees the end of the subscription
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	last := observer.events[len(observer.events)-1].(TopicStreamEvent)
+	require.Equal(t, TopicStreamError, last.Type)
+	require.Equal(t, codes.Canceled, status.Code(last.Err))
+}
+
+func TestUnitTopicMessageQueryReportsErrorAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		return status.Error(codes.Unavailable, "mirror node is down")
+	}}
+	client := _NewScriptedTopicClient(t, script, 2)
+
+	failed := make(chan status.Status, 1)
+	_, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetMaxAttempts(2).
+		SetErrorHandler(func(stat status.Status) { failed <- stat }).
+		Subscribe(client, func(TopicMessage) {})
+	require.NoError(t, err)
+
+	select {
+	case stat := <-failed:
+		require.Equal(t, codes.Unavailable, stat.Code())
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not fail")
+	}
+
+	script.mu.Lock()
+	defer script.mu.Unlock()
+	require.Equal(t, 3, script.calls)
+}
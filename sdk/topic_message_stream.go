@@ -0,0 +1,190 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// TopicStreamOverflowPolicy decides what a TopicMessageStream does with a message when its buffer is full
+type TopicStreamOverflowPolicy int
+
+const (
+	// TopicStreamOverflowBlock waits for the consumer, which holds back the mirror node stream
+	TopicStreamOverflowBlock TopicStreamOverflowPolicy = iota
+	// TopicStreamOverflowDropNewest drops the message which does not fit in the buffer
+	TopicStreamOverflowDropNewest
+	// TopicStreamOverflowDropOldest drops the oldest buffered message to make room for the new one
+	TopicStreamOverflowDropOldest
+)
+
+// defaultStreamBufferSize is the number of messages a TopicMessageStream buffers by default
+const defaultStreamBufferSize = 100
+
+// String returns the name of the policy
+func (policy TopicStreamOverflowPolicy) String() string {
+	switch policy {
+	case TopicStreamOverflowBlock:
+		return "BLOCK"
+	case TopicStreamOverflowDropNewest:
+		return "DROP_NEWEST"
+	case TopicStreamOverflowDropOldest:
+		return "DROP_OLDEST"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// TopicMessageStream delivers the messages of a TopicMessageQuery on a bounded channel. The channel is closed when
+// the subscription ends, after which Err reports why.
+type TopicMessageStream struct {
+	messages chan TopicMessage
+	policy   TopicStreamOverflowPolicy
+	cancel   context.CancelFunc
+	dropped  atomic.Uint64
+
+	mu     sync.Mutex
+	err    error
+	closed bool
+}
+
+// Messages returns the channel of messages, in consensus order. It is closed when the stream ends.
+func (stream *TopicMessageStream) Messages() <-chan TopicMessage {
+	return stream.messages
+}
+
+// Err returns the error which ended the stream once Messages is closed: nil when the stream completed at the end
+// time or limit of the query or was closed, the context error when the context ended, or the error of the
+// mirror node after the retries of the query.
+func (stream *TopicMessageStream) Err() error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	return stream.err
+}
+
+// Dropped returns the number of messages dropped by the overflow policy so far
+func (stream *TopicMessageStream) Dropped() uint64 {
+	return stream.dropped.Load()
+}
+
+// Close ends the stream. Messages is closed once the subscription has stopped.
+func (stream *TopicMessageStream) Close() {
+	stream.mu.Lock()
+	stream.closed = true
+	stream.mu.Unlock()
+
+	stream.cancel()
+}
+
+// _Push puts the message on the channel following the overflow policy, reporting whether it was put on it rather
+// than dropped or held back by the end of ctx
+func (stream *TopicMessageStream) _Push(ctx context.Context, message TopicMessage) bool {
+	switch stream.policy {
+	case TopicStreamOverflowDropNewest:
+		select {
+		case stream.messages <- message:
+			return true
+		default:
+			stream.dropped.Add(1)
+			return false
+		}
+	case TopicStreamOverflowDropOldest:
+		// Without a buffer there is no older message to drop, so the new one is
+		if cap(stream.messages) == 0 {
+			select {
+			case stream.messages <- message:
+				return true
+			default:
+				stream.dropped.Add(1)
+				return false
+			}
+		}
+
+		for {
+			select {
+			case stream.messages <- message:
+				return true
+			default:
+			}
+
+			select {
+			case <-stream.messages:
+				stream.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		select {
+		case stream.messages <- message:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// Stream subscribes to messages sent to the specific TopicID like Subscribe, but delivers them on the bounded
+// channel of the returned TopicMessageStream, sized by SetStreamBufferSize. When the buffer is full the
+// overflow policy set by SetStreamOverflowPolicy applies. The stream ends when ctx ends, Close is called or
+// the subscription completes or fails; the completion and error handlers of the query are not called.
+func (query *TopicMessageQuery) Stream(ctx context.Context, client *Client) (*TopicMessageStream, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// A message dropped after the checkpoint moved past it would never be delivered
+	if query.checkpointStore != nil && query.streamOverflowPolicy != TopicStreamOverflowBlock {
+		return nil, errStreamDropWithCheckpoint
+	}
+
+	subscription, err := query._NewSubscription(client)
+	if err != nil {
+		return nil, err
+	}
+
+	bufferSize := query.streamBufferSize
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+
+	subscriptionCtx, cancel := context.WithCancel(ctx)
+	stream := &TopicMessageStream{
+		messages: make(chan TopicMessage, bufferSize),
+		policy:   query.streamOverflowPolicy,
+		cancel:   cancel,
+	}
+
+	subscription.onNext = func(message TopicMessage) bool {
+		return stream._Push(subscriptionCtx, message)
+	}
+	subscription.onComplete = func() {}
+	subscription.onError = func(err error) {
+		// The end of the context or Close is reported below rather than as the CANCELLED status of the subscription
+		if subscriptionCtx.Err() != nil {
+			return
+		}
+
+		stream.mu.Lock()
+		stream.err = err
+		stream.mu.Unlock()
+	}
+
+	go func() {
+		defer cancel()
+
+		subscription._Run(subscriptionCtx)
+
+		stream.mu.Lock()
+		if stream.err == nil && !stream.closed && ctx.Err() != nil {
+			stream.err = ctx.Err()
+		}
+		stream.mu.Unlock()
+
+		close(stream.messages)
+	}()
+
+	return stream, nil
+}
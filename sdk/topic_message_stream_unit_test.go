@@ -0,0 +1,270 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// _SendTopicResponses sends the messages with the sequence numbers from 1 to count
+func _SendTopicResponses(stream mirror.ConsensusService_SubscribeTopicServer, count uint64) error {
+	for sequence := uint64(1); sequence <= count; sequence++ {
+		if err := stream.Send(_TopicResponse(sequence, nil)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func _CollectTopicStream(t *testing.T, stream *TopicMessageStream) []uint64 {
+	var received []uint64
+	for {
+		select {
+		case message, ok := <-stream.Messages():
+			if !ok {
+				return received
+			}
+			received = append(received, message.SequenceNumber)
+		case <-time.After(5 * time.Second):
+			t.Fatal("stream did not end")
+		}
+	}
+}
+
+func TestUnitTopicMessageStreamBlocksUntilConsumed(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		return _SendTopicResponses(stream, 5)
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	stream, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetStreamBufferSize(0).
+		Stream(context.Background(), client)
+	require.NoError(t, err)
+
+	time.Sleep(50 * time.Millisecond)
+
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, _CollectTopicStream(t, stream))
+	require.NoError(t, stream.Err())
+	require.Zero(t, stream.Dropped())
+}
+
+func TestUnitTopicMessageStreamEndsAtLimit(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		if err := _SendTopicResponses(stream, 5); err != nil {
+			return err
+		}
+
+		<-stream.Context().Done()
+		return nil
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	stream, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetLimit(3).
+		Stream(context.Background(), client)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 2, 3}, _CollectTopicStream(t, stream))
+	require.NoError(t, stream.Err())
+}
+
+func TestUnitTopicMessageStreamDropPolicies(t *testing.T) {
+	t.Parallel()
+
+	for policy, expected := range map[TopicStreamOverflowPolicy]uint64{
+		TopicStreamOverflowDropNewest: 1,
+		TopicStreamOverflowDropOldest: 3,
+	} {
+		policy, expected := policy, expected
+		t.Run(policy.String(), func(t *testing.T) {
+			t.Parallel()
+
+			script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+				return _SendTopicResponses(stream, 3)
+			}}
+			client := _NewScriptedTopicClient(t, script, 1)
+
+			stream, err := NewTopicMessageQuery().
+				SetTopicID(TopicID{Topic: 7}).
+				SetStreamBufferSize(1).
+				SetStreamOverflowPolicy(policy).
+				Stream(context.Background(), client)
+			require.NoError(t, err)
+
+			require.Eventually(t, func() bool { return stream.Dropped() == 2 }, 5*time.Second, 10*time.Millisecond)
+			require.Equal(t, []uint64{expected}, _CollectTopicStream(t, stream))
+			require.NoError(t, stream.Err())
+		})
+	}
+}
+
+func TestUnitTopicMessageStreamDropPoliciesRejectCheckpointStore(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		return _SendTopicResponses(stream, 3)
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	for _, policy := range []TopicStreamOverflowPolicy{TopicStreamOverflowDropNewest, TopicStreamOverflowDropOldest} {
+		store := NewInMemoryCheckpointStore()
+		_, err := NewTopicMessageQuery().
+			SetTopicID(TopicID{Topic: 7}).
+			SetStreamBufferSize(1).
+			SetStreamOverflowPolicy(policy).
+			SetCheckpointStore(store).
+			Stream(context.Background(), client)
+		require.ErrorIs(t, err, errStreamDropWithCheckpoint, policy.String())
+
+		checkpoint, err := store.LoadCheckpoint(TopicID{Topic: 7})
+		require.NoError(t, err)
+		require.Nil(t, checkpoint)
+	}
+
+	// Blocking never drops a message, so every checkpointed message is on the channel
+	store := NewInMemoryCheckpointStore()
+	stream, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetLimit(3).
+		SetStreamBufferSize(1).
+		SetCheckpointStore(store).
+		Stream(context.Background(), client)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, _CollectTopicStream(t, stream))
+
+	checkpoint, err := store.LoadCheckpoint(TopicID{Topic: 7})
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	require.Equal(t, uint64(3), checkpoint.SequenceNumber)
+}
+
+// _BlockedMessageObserver reports the sequence numbers of the messages handed to the stream
+type _BlockedMessageObserver struct {
+	NoopExecutionObserver
+	delivering chan uint64
+}
+
+func (observer _BlockedMessageObserver) OnTopicStream(event TopicStreamEvent) {
+	if event.Type == TopicStreamMessage {
+		observer.delivering <- event.SequenceNumber
+	}
+}
+
+func TestUnitTopicMessageStreamCancelKeepsCheckpointBeforeBlockedMessage(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		if err := _SendTopicResponses(stream, 2); err != nil {
+			return err
+		}
+
+		<-stream.Context().Done()
+		return nil
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+	observer := _BlockedMessageObserver{delivering: make(chan uint64, 2)}
+	client.SetExecutionObserver(observer)
+
+	store := NewInMemoryCheckpointStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetStreamBufferSize(1).
+		SetCheckpointStore(store).
+		Stream(ctx, client)
+	require.NoError(t, err)
+
+	// The first message fills the buffer, so the stream blocks on the second one until it is cancelled
+	require.Equal(t, uint64(1), <-observer.delivering)
+	require.Equal(t, uint64(2), <-observer.delivering)
+	cancel()
+
+	require.Equal(t, []uint64{1}, _CollectTopicStream(t, stream))
+	checkpoint, err := store.LoadCheckpoint(TopicID{Topic: 7})
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	require.Equal(t, uint64(1), checkpoint.SequenceNumber)
+}
+
+func TestUnitTopicMessageStreamContextCancel(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		if err := _SendTopicResponses(stream, 1); err != nil {
+			return err
+		}
+
+		<-stream.Context().Done()
+		return nil
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetStreamBufferSize(0).
+		Stream(ctx, client)
+	require.NoError(t, err)
+
+	message := <-stream.Messages()
+	require.Equal(t, uint64(1), message.SequenceNumber)
+
+	cancel()
+
+	require.Empty(t, _CollectTopicStream(t, stream))
+	require.ErrorIs(t, stream.Err(), context.Canceled)
+}
+
+func TestUnitTopicMessageStreamClose(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		<-stream.Context().Done()
+		return nil
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	stream, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		Stream(context.Background(), client)
+	require.NoError(t, err)
+
+	stream.Close()
+	require.Empty(t, _CollectTopicStream(t, stream))
+	require.NoError(t, stream.Err())
+}
+
+func TestUnitTopicMessageStreamError(t *testing.T) {
+	t.Parallel()
+
+	script := &_TopicScript{subscribe: func(call int, stream mirror.ConsensusService_SubscribeTopicServer) error {
+		return status.Error(codes.InvalidArgument, "invalid topic")
+	}}
+	client := _NewScriptedTopicClient(t, script, 1)
+
+	stream, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		Stream(context.Background(), client)
+	require.NoError(t, err)
+
+	require.Empty(t, _CollectTopicStream(t, stream))
+	require.Equal(t, codes.InvalidArgument, status.Code(stream.Err()))
+}
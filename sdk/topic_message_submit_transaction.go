@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/pkg/errors"
@@ -134,7 +135,11 @@ func (tx *TopicMessageSubmitTransaction) FreezeWith(client *Client) (*TopicMessa
 			return tx, errNoClientOrTransactionIDOrNodeId
 		}
 
-		tx.SetNodeAccountIDs(client.network._GetNodeAccountIDsForExecute())
+		nodeAccountIDs, err := client.network._GetNodeAccountIDsForExecute()
+		if err != nil {
+			return tx, err
+		}
+		tx.SetNodeAccountIDs(nodeAccountIDs)
 	}
 
 	tx._InitFee(client)
@@ -219,9 +224,17 @@ func (tx *TopicMessageSubmitTransaction) Schedule() (*ScheduleCreateTransaction,
 	return tx.Transaction.Schedule()
 }
 
-// Execute executes the Query with the provided client
+// Execute executes the Transaction with the provided client
 func (tx *TopicMessageSubmitTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping early when ctx is done.
+func (tx *TopicMessageSubmitTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -231,7 +244,7 @@ func (tx *TopicMessageSubmitTransaction) Execute(
 		return TransactionResponse{}, tx.freezeError
 	}
 
-	list, err := tx.ExecuteAll(client)
+	list, err := tx.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		return TransactionResponse{}, err
@@ -247,6 +260,14 @@ func (tx *TopicMessageSubmitTransaction) Execute(
 // ExecuteAll executes the all the Transactions with the provided client
 func (tx *TopicMessageSubmitTransaction) ExecuteAll(
 	client *Client,
+) ([]TransactionResponse, error) {
+	return tx.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes the all the Transactions with the provided client, stopping early when ctx is done.
+func (tx *TopicMessageSubmitTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
 ) ([]TransactionResponse, error) {
 	if !tx.IsFrozen() {
 		_, err := tx.FreezeWith(client)
@@ -271,7 +292,7 @@ func (tx *TopicMessageSubmitTransaction) ExecuteAll(
 	list := make([]TransactionResponse, size)
 
 	for i := 0; i < size; i++ {
-		resp, err := _Execute(client, tx)
+		resp, err := _Execute(ctx, client, tx)
 
 		if err != nil {
 			return []TransactionResponse{}, err
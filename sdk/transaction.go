@@ -4,6 +4,7 @@ package hiero
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha512"
 	"fmt"
 	"reflect"
@@ -1405,7 +1406,14 @@ func (tx *Transaction[T]) regenerateID(client *Client) bool {
 	return false
 }
 
+// Execute executes the transaction with the provided client
 func (tx *Transaction[T]) Execute(client *Client) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the transaction with the provided client. Cancellation and deadlines of ctx
+// are honored by the gRPC calls and by the backoff waits between attempts.
+func (tx *Transaction[T]) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
 	}
@@ -1438,7 +1446,7 @@ func (tx *Transaction[T]) Execute(client *Client) (TransactionResponse, error) {
 		tx.grpcDeadline = client.requestTimeout
 	}
 
-	resp, err := _Execute(client, tx.childTransaction)
+	resp, err := _Execute(ctx, client, tx.childTransaction)
 
 	if err != nil {
 		return TransactionResponse{
@@ -1484,7 +1492,11 @@ func (tx *Transaction[T]) FreezeWith(client *Client) (T, error) {
 
 	if tx.nodeAccountIDs._IsEmpty() {
 		if client != nil {
-			for _, nodeAccountID := range client.network._GetNodeAccountIDsForExecute() {
+			nodeAccountIDs, err := client.network._GetNodeAccountIDsForExecute()
+			if err != nil {
+				return tx.childTransaction, err
+			}
+			for _, nodeAccountID := range nodeAccountIDs {
 				tx.nodeAccountIDs._Push(nodeAccountID)
 			}
 		} else {
@@ -1610,12 +1622,22 @@ func (tx *Transaction[T]) SetLogLevel(level LogLevel) T {
 	return tx.childTransaction
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this transaction are retried, overriding the client's.
+func (tx *Transaction[T]) SetRetryPolicy(policy RetryPolicy) T {
+	tx.retryPolicy = policy
+	return tx.childTransaction
+}
+
 // Static Utility functions //
 
 func TransactionExecute(tx TransactionInterface, client *Client) (TransactionResponse, error) {
 	return tx.getBaseTransaction().Execute(client)
 }
 
+func TransactionExecuteWithContext(ctx context.Context, tx TransactionInterface, client *Client) (TransactionResponse, error) {
+	return tx.getBaseTransaction().ExecuteWithContext(ctx, client)
+}
+
 func TransactionSign(tx TransactionInterface, key PrivateKey) (TransactionInterface, error) {
 	baseTx := tx.getBaseTransaction()
 	baseTx.Sign(key)
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -78,12 +79,17 @@ func (q *TransactionReceiptQuery) GetIncludeDuplicates() bool {
 }
 
 func (q *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *TransactionReceiptQuery) Execute(client *Client) (TransactionReceipt, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *TransactionReceiptQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err, ok := err.(ErrHederaPreCheckStatus); ok {
 		if resp.GetTransactionGetReceipt() != nil {
@@ -93,6 +99,10 @@ func (q *TransactionReceiptQuery) Execute(client *Client) (TransactionReceipt, e
 		return TransactionReceipt{Status: err.Status}, err
 	}
 
+	if err != nil {
+		return TransactionReceipt{}, err
+	}
+
 	return _TransactionReceiptFromProtobuf(resp.GetTransactionGetReceipt(), q.transactionID), nil
 }
 
@@ -159,6 +169,12 @@ func (q *TransactionReceiptQuery) SetLogLevel(level LogLevel) *TransactionReceip
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *TransactionReceiptQuery) SetRetryPolicy(policy RetryPolicy) *TransactionReceiptQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *TransactionReceiptQuery) getMethod(channel *_Channel) _Method {
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -82,12 +83,17 @@ func (q *TransactionRecordQuery) GetIncludeDuplicates() bool {
 }
 
 func (q *TransactionRecordQuery) GetCost(client *Client) (Hbar, error) {
-	return q.Query.getCost(client, q)
+	return q.Query.getCost(context.Background(), client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *TransactionRecordQuery) Execute(client *Client) (TransactionRecord, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early when ctx is done.
+func (q *TransactionRecordQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
+	resp, err := q.Query.execute(ctx, client, q)
 
 	if err != nil {
 		if precheckErr, ok := err.(ErrHederaPreCheckStatus); ok {
@@ -162,6 +168,12 @@ func (q *TransactionRecordQuery) SetLogLevel(level LogLevel) *TransactionRecordQ
 	return q
 }
 
+// SetRetryPolicy sets the policy deciding whether failed attempts of this query are retried, overriding the client's.
+func (q *TransactionRecordQuery) SetRetryPolicy(policy RetryPolicy) *TransactionRecordQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // ---------- Parent functions specific implementation ----------
 
 func (q *TransactionRecordQuery) getMethod(channel *_Channel) _Method {
@@ -1,6 +1,7 @@
 package hiero
 
 import (
+	"context"
 	"encoding/hex"
 	"time"
 
@@ -36,21 +37,31 @@ func (response TransactionResponse) MarshalJSON() ([]byte, error) {
 }
 
 // retryTransaction is a helper function to retry a transaction that was throttled
-func (response *TransactionResponse) retryTransaction(client *Client) (TransactionReceipt, error) { // nolint
+func (response *TransactionResponse) retryTransaction(ctx context.Context, client *Client) (TransactionReceipt, error) { // nolint
 	maxRetries := 5
 	backoff := 250 * time.Millisecond
 
+	var resp TransactionResponse
 	var receipt TransactionReceipt
 	var err error
 
 	for i := 0; i < maxRetries; i++ {
 		if i > 0 {
-			time.Sleep(backoff)
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return receipt, ErrExecutionInterrupted{Attempt: int64(i + 1), MaxAttempts: maxRetries, Cause: ctx.Err(), LastError: err}
+			case <-timer.C:
+			}
 			backoff *= 2 // Double the backoff for next retry
 		}
 
-		resp, err := TransactionExecute(response.Transaction, client)
+		resp, err = TransactionExecuteWithContext(ctx, response.Transaction, client)
 		if err != nil {
+			if _, ok := err.(ErrExecutionInterrupted); ok {
+				return receipt, err
+			}
 			continue
 		}
 
@@ -58,7 +69,7 @@ func (response *TransactionResponse) retryTransaction(client *Client) (Transacti
 			SetTransactionID(resp.TransactionID).
 			SetNodeAccountIDs([]AccountID{resp.NodeID}).
 			SetIncludeChildren(response.IncludeChildReceipts).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 
 		if err == nil && receipt.Status != StatusThrottledAtConsensus {
 			// Set the transaction ID if the transaction was successful
@@ -72,14 +83,20 @@ func (response *TransactionResponse) retryTransaction(client *Client) (Transacti
 
 // GetReceipt retrieves the receipt for the transaction
 func (response *TransactionResponse) GetReceipt(client *Client) (TransactionReceipt, error) {
+	return response.GetReceiptWithContext(context.Background(), client)
+}
+
+// GetReceiptWithContext retrieves the receipt for the transaction, polling until the receipt is available
+// or ctx is done.
+func (response *TransactionResponse) GetReceiptWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
 		SetIncludeChildren(response.IncludeChildReceipts).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if receipt.Status == StatusThrottledAtConsensus {
-		receipt, err = response.retryTransaction(client)
+		receipt, err = response.retryTransaction(ctx, client)
 	}
 
 	if err != nil {
@@ -91,14 +108,20 @@ func (response *TransactionResponse) GetReceipt(client *Client) (TransactionRece
 
 // GetRecord retrieves the record for the transaction
 func (response *TransactionResponse) GetRecord(client *Client) (TransactionRecord, error) {
+	return response.GetRecordWithContext(context.Background(), client)
+}
+
+// GetRecordWithContext retrieves the record for the transaction, polling for the receipt first until it is
+// available or ctx is done.
+func (response *TransactionResponse) GetRecordWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
 		SetIncludeChildren(response.IncludeChildReceipts).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if receipt.Status == StatusThrottledAtConsensus {
-		receipt, err = response.retryTransaction(client)
+		receipt, err = response.retryTransaction(ctx, client)
 	}
 
 	if err != nil {
@@ -109,7 +132,7 @@ func (response *TransactionResponse) GetRecord(client *Client) (TransactionRecor
 	return NewTransactionRecordQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 }
 
 // GetReceiptQuery retrieves the receipt query for the transaction
@@ -562,7 +562,8 @@ func TestUnitTransactionAttributes(t *testing.T) {
 	client, err := _NewMockClient()
 	require.NoError(t, err)
 	client.SetLedgerID(*NewLedgerIDTestnet())
-	nodeAccountIds := client.network._GetNodeAccountIDsForExecute()
+	nodeAccountIds, err := client.network._GetNodeAccountIDsForExecute()
+	require.NoError(t, err)
 
 	txs := []TransactionInterface{
 		NewAccountCreateTransaction(),
@@ -629,7 +630,8 @@ func TestUnitTransactionAttributesDereferanced(t *testing.T) {
 	client, err := _NewMockClient()
 	require.NoError(t, err)
 	client.SetLedgerID(*NewLedgerIDTestnet())
-	nodeAccountIds := client.network._GetNodeAccountIDsForExecute()
+	nodeAccountIds, err := client.network._GetNodeAccountIDsForExecute()
+	require.NoError(t, err)
 
 	txs := []TransactionInterface{
 		NewAccountCreateTransaction(),
@@ -1439,7 +1441,8 @@ func signSwitchCaseaSetup(t *testing.T) (PrivateKey, *Client, AccountID) {
 	require.NoError(t, err)
 	client.SetLedgerID(*NewLedgerIDTestnet())
 
-	nodeAccountIds := client.network._GetNodeAccountIDsForExecute()
+	nodeAccountIds, err := client.network._GetNodeAccountIDsForExecute()
+	require.NoError(t, err)
 	nodeAccountId := nodeAccountIds[0]
 
 	return newKey, client, nodeAccountId
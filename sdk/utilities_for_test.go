@@ -116,7 +116,8 @@ func NewIntegrationTestEnv(t *testing.T) IntegrationTestEnv {
 	env.OriginalOperatorID = env.Client.GetOperatorAccountID()
 	env.OriginalOperatorKey = env.Client.GetOperatorPublicKey()
 
-	env.NodeAccountIDs = env.Client.network._GetNodeAccountIDsForExecute()
+	env.NodeAccountIDs, err = env.Client.network._GetNodeAccountIDsForExecute()
+	require.NoError(t, err)
 	return env
 }
 
@@ -0,0 +1,518 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"crypto/aes"
+	cipher2 "crypto/cipher"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// WalletKDFScrypt is the scrypt key derivation function
+	WalletKDFScrypt = "scrypt"
+	// WalletKDFArgon2id is the argon2id key derivation function
+	WalletKDFArgon2id = "argon2id"
+)
+
+const walletVersion = 1
+const walletCipher = "aes-256-gcm"
+
+// WalletKDF is the key derivation function, and its parameters, deriving the encryption key of a Wallet from its
+// passphrase
+type WalletKDF struct {
+	// Name is WalletKDFScrypt or WalletKDFArgon2id
+	Name string
+	// N, R and P are the CPU/memory cost, the block size and the parallelization of scrypt
+	N int
+	R int
+	P int
+	// Time, Memory in KiB and Threads are the passes, the memory and the parallelism of argon2id
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
+// NewWalletKDFScrypt returns the scrypt key derivation function with the parameters of Ethereum keystores,
+// N = 2^18, r = 8 and p = 1
+func NewWalletKDFScrypt() WalletKDF {
+	return WalletKDF{Name: WalletKDFScrypt, N: 1 << 18, R: 8, P: 1}
+}
+
+// NewWalletKDFArgon2id returns the argon2id key derivation function with 3 passes over 64 MiB with 4 threads
+func NewWalletKDFArgon2id() WalletKDF {
+	return WalletKDF{Name: WalletKDFArgon2id, Time: 3, Memory: 64 * 1024, Threads: 4}
+}
+
+// The bounds of the KDF parameters, so a wallet file cannot make the key derivation exhaust the memory or run for
+// hours before its authentication tag is checked. scrypt uses 128 * N * r bytes, up to 4 GiB.
+const (
+	walletMaxScryptN       = 1 << 20
+	walletMaxScryptR       = 32
+	walletMaxScryptP       = 16
+	walletMaxArgon2Time    = 64
+	walletMaxArgon2Memory  = 4 * 1024 * 1024
+	walletMaxArgon2Threads = 64
+)
+
+// _Validate checks the parameters of the KDF are supported and within bounds
+func (kdf WalletKDF) _Validate() error {
+	switch kdf.Name {
+	case WalletKDFScrypt:
+		if kdf.N < 2 || kdf.N > walletMaxScryptN || kdf.N&(kdf.N-1) != 0 || kdf.R < 1 || kdf.R > walletMaxScryptR ||
+			kdf.P < 1 || kdf.P > walletMaxScryptP {
+			return _NewErrBadKeyf("invalid scrypt parameters: N %d, r %d, p %d", kdf.N, kdf.R, kdf.P)
+		}
+	case WalletKDFArgon2id:
+		if kdf.Time == 0 || kdf.Time > walletMaxArgon2Time || kdf.Memory == 0 || kdf.Memory > walletMaxArgon2Memory ||
+			kdf.Threads == 0 || kdf.Threads > walletMaxArgon2Threads {
+			return _NewErrBadKeyf("invalid argon2id parameters: time %d, memory %d, threads %d", kdf.Time, kdf.Memory, kdf.Threads)
+		}
+	default:
+		return _NewErrBadKeyf("unsupported KDF: %v", kdf.Name)
+	}
+
+	return nil
+}
+
+func (kdf WalletKDF) _DeriveKey(passphrase string, salt []byte) ([]byte, error) {
+	if err := kdf._Validate(); err != nil {
+		return nil, err
+	}
+
+	if kdf.Name == WalletKDFScrypt {
+		return scrypt.Key([]byte(passphrase), salt, kdf.N, kdf.R, kdf.P, 32)
+	}
+	return argon2.IDKey([]byte(passphrase), salt, kdf.Time, kdf.Memory, kdf.Threads, 32), nil
+}
+
+// WalletEntry is a labeled key of a Wallet, with the account it belongs to and where it was derived from
+type WalletEntry struct {
+	// Label identifies the entry in the wallet
+	Label      string
+	AccountID  *AccountID
+	PrivateKey PrivateKey
+	// DerivationPath and Mnemonic are where the key was derived from, if it was
+	DerivationPath string
+	Mnemonic       string
+}
+
+// KeyType returns the type of the key of the entry, ED25519 or ECDSA_SECP256K1
+func (entry WalletEntry) KeyType() string {
+	if entry.PrivateKey.ecdsaPrivateKey != nil {
+		return "ECDSA_SECP256K1"
+	}
+
+	return "ED25519"
+}
+
+// Wallet holds many labeled keys, encrypted under one passphrase when written with ToBytes or SaveFile. The
+// encryption key is derived from the passphrase with scrypt or argon2id, and encrypts the entries with
+// AES-256-GCM. A Wallet is safe for concurrent use.
+type Wallet struct {
+	mu         sync.Mutex
+	passphrase string
+	kdf        WalletKDF
+	entries    []WalletEntry
+}
+
+// internal type of the wallet file
+type _WalletFile struct {
+	Version    uint8          `json:"version"`
+	KDF        _WalletFileKDF `json:"kdf"`
+	Cipher     string         `json:"cipher"`
+	Nonce      string         `json:"nonce"`
+	CipherText string         `json:"ciphertext"`
+}
+
+// internal type of the KDF and its parameters in the wallet file
+type _WalletFileKDF struct {
+	Name    string `json:"name"`
+	Salt    string `json:"salt"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// internal type of an entry in the encrypted part of the wallet file
+type _WalletFileEntry struct {
+	Label          string `json:"label"`
+	AccountID      string `json:"account_id,omitempty"`
+	KeyType        string `json:"key_type"`
+	PrivateKey     string `json:"private_key"`
+	DerivationPath string `json:"derivation_path,omitempty"`
+	Mnemonic       string `json:"mnemonic,omitempty"`
+}
+
+// NewWallet creates an empty Wallet encrypted under the passphrase with scrypt
+func NewWallet(passphrase string) *Wallet {
+	return &Wallet{passphrase: passphrase, kdf: NewWalletKDFScrypt()}
+}
+
+// SetKDF sets the key derivation function deriving the encryption key from the passphrase. It panics when the
+// KDF is not supported or its parameters are out of bounds: scrypt N a power of two up to 2^20, r up to 32 and p up
+// to 16, argon2id up to 64 passes over up to 4 GiB with up to 64 threads.
+func (wallet *Wallet) SetKDF(kdf WalletKDF) *Wallet {
+	if err := kdf._Validate(); err != nil {
+		panic(err.Error())
+	}
+
+	wallet.mu.Lock()
+	defer wallet.mu.Unlock()
+
+	wallet.kdf = kdf
+	return wallet
+}
+
+// GetKDF returns the key derivation function deriving the encryption key from the passphrase
+func (wallet *Wallet) GetKDF() WalletKDF {
+	wallet.mu.Lock()
+	defer wallet.mu.Unlock()
+
+	return wallet.kdf
+}
+
+// ChangePassphrase changes the passphrase the wallet is encrypted under, once the current one is given
+func (wallet *Wallet) ChangePassphrase(oldPassphrase string, newPassphrase string) error {
+	wallet.mu.Lock()
+	defer wallet.mu.Unlock()
+
+	if oldPassphrase != wallet.passphrase {
+		return _NewErrBadKeyf("passphrase is incorrect")
+	}
+
+	wallet.passphrase = newPassphrase
+	return nil
+}
+
+// AddEntry adds an entry to the wallet. Its label must not be empty, nor the label of another entry.
+func (wallet *Wallet) AddEntry(entry WalletEntry) error {
+	wallet.mu.Lock()
+	defer wallet.mu.Unlock()
+
+	if entry.Label == "" {
+		return errors.New("wallet entry label is required")
+	}
+	if entry.PrivateKey.ecdsaPrivateKey == nil && entry.PrivateKey.ed25519PrivateKey == nil {
+		return errors.Errorf("wallet entry %q has no private key", entry.Label)
+	}
+	if wallet._Index(entry.Label) >= 0 {
+		return errors.Errorf("wallet already has an entry %q", entry.Label)
+	}
+
+	wallet.entries = append(wallet.entries, entry)
+	return nil
+}
+
+// GetEntry returns the entry with the label
+func (wallet *Wallet) GetEntry(label string) (WalletEntry, bool) {
+	wallet.mu.Lock()
+	defer wallet.mu.Unlock()
+
+	index := wallet._Index(label)
+	if index < 0 {
+		return WalletEntry{}, false
+	}
+
+	return wallet.entries[index], true
+}
+
+// GetEntries returns the entries of the wallet, in the order they were added
+func (wallet *Wallet) GetEntries() []WalletEntry {
+	wallet.mu.Lock()
+	defer wallet.mu.Unlock()
+
+	return append([]WalletEntry{}, wallet.entries...)
+}
+
+// RemoveEntry removes the entry with the label
+func (wallet *Wallet) RemoveEntry(label string) error {
+	wallet.mu.Lock()
+	defer wallet.mu.Unlock()
+
+	index := wallet._Index(label)
+	if index < 0 {
+		return errors.Errorf("wallet has no entry %q", label)
+	}
+
+	wallet.entries = append(wallet.entries[:index], wallet.entries[index+1:]...)
+	return nil
+}
+
+// RotateEntry replaces the key of the entry with the label, after the key of its account was updated. The
+// derivation path and mnemonic of the old key are dropped, as they do not derive the new key.
+func (wallet *Wallet) RotateEntry(label string, privateKey PrivateKey) error {
+	wallet.mu.Lock()
+	defer wallet.mu.Unlock()
+
+	index := wallet._Index(label)
+	if index < 0 {
+		return errors.Errorf("wallet has no entry %q", label)
+	}
+	if privateKey.ecdsaPrivateKey == nil && privateKey.ed25519PrivateKey == nil {
+		return errors.Errorf("wallet entry %q has no private key", label)
+	}
+
+	entry := &wallet.entries[index]
+	entry.PrivateKey = privateKey
+	entry.DerivationPath = ""
+	entry.Mnemonic = ""
+	return nil
+}
+
+// ImportKeystore adds an entry with the key of a single key keystore, written by PrivateKey.Keystore
+func (wallet *Wallet) ImportKeystore(label string, accountID *AccountID, keystore []byte, passphrase string) error {
+	privateKey, err := PrivateKeyFromKeystore(keystore, passphrase)
+	if err != nil {
+		return err
+	}
+
+	return wallet.AddEntry(WalletEntry{Label: label, AccountID: accountID, PrivateKey: privateKey})
+}
+
+func (wallet *Wallet) _Index(label string) int {
+	for i, entry := range wallet.entries {
+		if entry.Label == label {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ToBytes encrypts the wallet under its passphrase
+func (wallet *Wallet) ToBytes() ([]byte, error) {
+	wallet.mu.Lock()
+	defer wallet.mu.Unlock()
+
+	entries := make([]_WalletFileEntry, 0, len(wallet.entries))
+	for _, entry := range wallet.entries {
+		fileEntry := _WalletFileEntry{
+			Label:          entry.Label,
+			KeyType:        entry.KeyType(),
+			PrivateKey:     entry.PrivateKey.StringRaw(),
+			DerivationPath: entry.DerivationPath,
+			Mnemonic:       entry.Mnemonic,
+		}
+		if entry.AccountID != nil {
+			fileEntry.AccountID = entry.AccountID.String()
+		}
+		entries = append(entries, fileEntry)
+	}
+
+	plainText, err := json.Marshal(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := _RandomBytes(saltLen)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := _RandomBytes(12)
+	if err != nil {
+		return nil, err
+	}
+
+	file := _WalletFile{
+		Version: walletVersion,
+		KDF: _WalletFileKDF{
+			Name:    wallet.kdf.Name,
+			Salt:    hex.EncodeToString(salt),
+			N:       wallet.kdf.N,
+			R:       wallet.kdf.R,
+			P:       wallet.kdf.P,
+			Time:    wallet.kdf.Time,
+			Memory:  wallet.kdf.Memory,
+			Threads: wallet.kdf.Threads,
+		},
+		Cipher: walletCipher,
+		Nonce:  hex.EncodeToString(nonce),
+	}
+
+	aead, err := _WalletAEAD(wallet.kdf, wallet.passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	additionalData, err := file._AdditionalData()
+	if err != nil {
+		return nil, err
+	}
+	file.CipherText = hex.EncodeToString(aead.Seal(nil, nonce, plainText, additionalData))
+
+	return json.MarshalIndent(file, "", "  ")
+}
+
+// WalletFromBytes decrypts a wallet written by Wallet.ToBytes with its passphrase
+func WalletFromBytes(data []byte, passphrase string) (*Wallet, error) {
+	file := _WalletFile{}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	if file.Version != walletVersion {
+		return nil, _NewErrBadKeyf("unsupported wallet version: %v", file.Version)
+	}
+	if file.Cipher != walletCipher {
+		return nil, _NewErrBadKeyf("unsupported wallet cipher: %v", file.Cipher)
+	}
+
+	salt, err := hex.DecodeString(file.KDF.Salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(file.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(file.CipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	kdf := WalletKDF{
+		Name:    file.KDF.Name,
+		N:       file.KDF.N,
+		R:       file.KDF.R,
+		P:       file.KDF.P,
+		Time:    file.KDF.Time,
+		Memory:  file.KDF.Memory,
+		Threads: file.KDF.Threads,
+	}
+	// The parameters are not authenticated until the key is derived
+	if err := kdf._Validate(); err != nil {
+		return nil, err
+	}
+	aead, err := _WalletAEAD(kdf, passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, _NewErrBadKeyf("invalid wallet nonce length: %d", len(nonce))
+	}
+	additionalData, err := file._AdditionalData()
+	if err != nil {
+		return nil, err
+	}
+
+	plainText, err := aead.Open(nil, nonce, cipherText, additionalData)
+	if err != nil {
+		return nil, _NewErrBadKeyf("wallet authentication failed; passphrase is incorrect or the file was modified")
+	}
+
+	var fileEntries []_WalletFileEntry
+	if err := json.Unmarshal(plainText, &fileEntries); err != nil {
+		return nil, err
+	}
+
+	wallet := &Wallet{passphrase: passphrase, kdf: kdf}
+	for _, fileEntry := range fileEntries {
+		entry := WalletEntry{
+			Label:          fileEntry.Label,
+			DerivationPath: fileEntry.DerivationPath,
+			Mnemonic:       fileEntry.Mnemonic,
+		}
+
+		switch fileEntry.KeyType {
+		case "ED25519":
+			entry.PrivateKey, err = PrivateKeyFromStringEd25519(fileEntry.PrivateKey)
+		case "ECDSA_SECP256K1":
+			entry.PrivateKey, err = PrivateKeyFromStringECDSA(fileEntry.PrivateKey)
+		default:
+			err = _NewErrBadKeyf("unsupported key type: %v", fileEntry.KeyType)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the key of wallet entry %q", fileEntry.Label)
+		}
+
+		if fileEntry.AccountID != "" {
+			accountID, err := AccountIDFromString(fileEntry.AccountID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read the account ID of wallet entry %q", fileEntry.Label)
+			}
+			entry.AccountID = &accountID
+		}
+
+		if err := wallet.AddEntry(entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return wallet, nil
+}
+
+// OpenWalletFile reads and decrypts the wallet file at the path
+func OpenWalletFile(path string, passphrase string) (*Wallet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return WalletFromBytes(data, passphrase)
+}
+
+// SaveFile encrypts the wallet and writes it to the path, readable by the owner only. The file is replaced
+// atomically, so a failed write keeps the previous wallet.
+func (wallet *Wallet) SaveFile(path string) error {
+	data, err := wallet.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if err := file.Chmod(0o600); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// _AdditionalData authenticates the header of the wallet file with the entries, so its KDF parameters cannot be
+// changed without the passphrase
+func (file _WalletFile) _AdditionalData() ([]byte, error) {
+	header := file
+	header.CipherText = ""
+	return json.Marshal(header)
+}
+
+func _WalletAEAD(kdf WalletKDF, passphrase string, salt []byte) (cipher2.AEAD, error) {
+	key, err := kdf._DeriveKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher2.NewGCM(block)
+}
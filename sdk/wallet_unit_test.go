@@ -0,0 +1,218 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Cheap parameters, so the tests do not spend seconds deriving keys
+var _TestWalletScrypt = WalletKDF{Name: WalletKDFScrypt, N: 1 << 10, R: 8, P: 1}
+var _TestWalletArgon2id = WalletKDF{Name: WalletKDFArgon2id, Time: 1, Memory: 1024, Threads: 1}
+
+func _TestWallet(t *testing.T) *Wallet {
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	ecdsaKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	wallet := NewWallet("correct horse").SetKDF(_TestWalletScrypt)
+	require.NoError(t, wallet.AddEntry(WalletEntry{
+		Label:          "treasury",
+		AccountID:      &AccountID{Account: 1001},
+		PrivateKey:     ed25519Key,
+		DerivationPath: "m/44'/3030'/0'/0'/0'",
+		Mnemonic:       "word word word",
+	}))
+	require.NoError(t, wallet.AddEntry(WalletEntry{Label: "relayer", PrivateKey: ecdsaKey}))
+
+	return wallet
+}
+
+func TestUnitWalletRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, kdf := range []WalletKDF{_TestWalletScrypt, _TestWalletArgon2id} {
+		wallet := _TestWallet(t).SetKDF(kdf)
+		data, err := wallet.ToBytes()
+		require.NoError(t, err)
+
+		decrypted, err := WalletFromBytes(data, "correct horse")
+		require.NoError(t, err, kdf.Name)
+		assert.Equal(t, kdf, decrypted.GetKDF())
+
+		entries := decrypted.GetEntries()
+		require.Len(t, entries, 2)
+		for i, entry := range wallet.GetEntries() {
+			assert.Equal(t, entry.Label, entries[i].Label)
+			assert.Equal(t, entry.AccountID, entries[i].AccountID)
+			assert.Equal(t, entry.KeyType(), entries[i].KeyType())
+			assert.Equal(t, entry.PrivateKey.StringRaw(), entries[i].PrivateKey.StringRaw())
+			assert.Equal(t, entry.DerivationPath, entries[i].DerivationPath)
+			assert.Equal(t, entry.Mnemonic, entries[i].Mnemonic)
+		}
+		assert.Equal(t, "ED25519", entries[0].KeyType())
+		assert.Equal(t, "ECDSA_SECP256K1", entries[1].KeyType())
+	}
+}
+
+func TestUnitWalletWrongPassphraseAndTampering(t *testing.T) {
+	t.Parallel()
+
+	data, err := _TestWallet(t).ToBytes()
+	require.NoError(t, err)
+
+	_, err = WalletFromBytes(data, "wrong horse")
+	require.ErrorContains(t, err, "passphrase is incorrect")
+
+	// The KDF parameters are authenticated with the entries
+	var file map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &file))
+	file["kdf"].(map[string]interface{})["n"] = 2048
+	tampered, err := json.Marshal(file)
+	require.NoError(t, err)
+	_, err = WalletFromBytes(tampered, "correct horse")
+	require.ErrorContains(t, err, "wallet authentication failed")
+
+	file["version"] = 2
+	tampered, err = json.Marshal(file)
+	require.NoError(t, err)
+	_, err = WalletFromBytes(tampered, "correct horse")
+	require.ErrorContains(t, err, "unsupported wallet version")
+}
+
+func TestUnitWalletRejectsOutOfBoundsKDFParameters(t *testing.T) {
+	t.Parallel()
+
+	data, err := _TestWallet(t).ToBytes()
+	require.NoError(t, err)
+
+	// Out of bounds parameters are rejected before deriving a key, which could exhaust the memory
+	for _, kdf := range []map[string]interface{}{
+		{"name": "scrypt", "n": 1 << 40, "r": 8, "p": 1},
+		{"name": "scrypt", "n": 1000, "r": 8, "p": 1},
+		{"name": "scrypt", "n": 1024, "r": 1 << 20, "p": 1},
+		{"name": "scrypt", "n": 1024, "r": 8, "p": 0},
+		{"name": "argon2id", "time": 1, "memory": 4294967295, "threads": 1},
+		{"name": "argon2id", "time": 4294967295, "memory": 1024, "threads": 1},
+		{"name": "argon2id", "time": 1, "memory": 1024, "threads": 255},
+		{"name": "pbkdf2"},
+	} {
+		var file map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &file))
+		kdf["salt"] = file["kdf"].(map[string]interface{})["salt"]
+		file["kdf"] = kdf
+		tampered, err := json.Marshal(file)
+		require.NoError(t, err)
+
+		_, err = WalletFromBytes(tampered, "correct horse")
+		var badKey ErrBadKey
+		require.ErrorAs(t, err, &badKey, kdf)
+		require.NotContains(t, err.Error(), "wallet authentication failed", kdf)
+	}
+
+	require.Panics(t, func() {
+		NewWallet("correct horse").SetKDF(WalletKDF{Name: WalletKDFArgon2id, Time: 1, Memory: 1 << 30, Threads: 1})
+	})
+	require.Panics(t, func() {
+		NewWallet("correct horse").SetKDF(WalletKDF{Name: WalletKDFScrypt, N: 1 << 21, R: 8, P: 1})
+	})
+	require.NotPanics(t, func() {
+		NewWallet("correct horse").SetKDF(NewWalletKDFScrypt()).SetKDF(NewWalletKDFArgon2id())
+	})
+}
+
+func TestUnitWalletEntries(t *testing.T) {
+	t.Parallel()
+
+	wallet := _TestWallet(t)
+
+	require.ErrorContains(t, wallet.AddEntry(WalletEntry{Label: "relayer", PrivateKey: wallet.GetEntries()[1].PrivateKey}), "already has an entry")
+	require.ErrorContains(t, wallet.AddEntry(WalletEntry{PrivateKey: wallet.GetEntries()[1].PrivateKey}), "label is required")
+	require.ErrorContains(t, wallet.AddEntry(WalletEntry{Label: "empty"}), "has no private key")
+
+	newKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	require.NoError(t, wallet.RotateEntry("treasury", newKey))
+	treasury, ok := wallet.GetEntry("treasury")
+	require.True(t, ok)
+	assert.Equal(t, newKey.StringRaw(), treasury.PrivateKey.StringRaw())
+	assert.Equal(t, &AccountID{Account: 1001}, treasury.AccountID)
+	assert.Empty(t, treasury.DerivationPath)
+	assert.Empty(t, treasury.Mnemonic)
+	require.ErrorContains(t, wallet.RotateEntry("missing", newKey), "no entry")
+
+	require.NoError(t, wallet.RemoveEntry("relayer"))
+	_, ok = wallet.GetEntry("relayer")
+	assert.False(t, ok)
+	require.ErrorContains(t, wallet.RemoveEntry("relayer"), "no entry")
+	assert.Len(t, wallet.GetEntries(), 1)
+}
+
+func TestUnitWalletChangePassphrase(t *testing.T) {
+	t.Parallel()
+
+	wallet := _TestWallet(t)
+	require.ErrorContains(t, wallet.ChangePassphrase("wrong horse", "battery staple"), "passphrase is incorrect")
+	require.NoError(t, wallet.ChangePassphrase("correct horse", "battery staple"))
+
+	data, err := wallet.ToBytes()
+	require.NoError(t, err)
+	_, err = WalletFromBytes(data, "correct horse")
+	require.Error(t, err)
+	decrypted, err := WalletFromBytes(data, "battery staple")
+	require.NoError(t, err)
+	assert.Len(t, decrypted.GetEntries(), 2)
+}
+
+func TestUnitWalletImportKeystore(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	keystore, err := key.Keystore("keystore passphrase")
+	require.NoError(t, err)
+
+	wallet := NewWallet("correct horse").SetKDF(_TestWalletArgon2id)
+	require.ErrorContains(t, wallet.ImportKeystore("imported", nil, keystore, "wrong"), "passphrase is incorrect")
+	require.NoError(t, wallet.ImportKeystore("imported", &AccountID{Account: 5}, keystore, "keystore passphrase"))
+
+	imported, ok := wallet.GetEntry("imported")
+	require.True(t, ok)
+	assert.Equal(t, key.StringRaw(), imported.PrivateKey.StringRaw())
+	assert.Equal(t, &AccountID{Account: 5}, imported.AccountID)
+}
+
+func TestUnitWalletFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "wallet.json")
+	wallet := _TestWallet(t)
+	require.NoError(t, wallet.SaveFile(path))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	opened, err := OpenWalletFile(path, "correct horse")
+	require.NoError(t, err)
+	assert.Len(t, opened.GetEntries(), 2)
+
+	require.NoError(t, opened.RemoveEntry("treasury"))
+	require.NoError(t, opened.SaveFile(path))
+	opened, err = OpenWalletFile(path, "correct horse")
+	require.NoError(t, err)
+	assert.Len(t, opened.GetEntries(), 1)
+
+	_, err = OpenWalletFile(filepath.Join(t.TempDir(), "missing.json"), "correct horse")
+	require.Error(t, err)
+}
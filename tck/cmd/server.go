@@ -37,40 +37,64 @@ func main() {
 	fileService := new(methods.FileService)
 	fileService.SetSdkService(sdkService)
 
+	topicService := new(methods.TopicService)
+	topicService.SetSdkService(sdkService)
+
+	contractService := new(methods.ContractService)
+	contractService.SetSdkService(sdkService)
+
+	scheduleService := new(methods.ScheduleService)
+	scheduleService.SetSdkService(sdkService)
+
+	ethereumService := new(methods.EthereumService)
+	ethereumService.SetSdkService(sdkService)
+
 	// Create a new RPC server
 	assigner := handler.Map{
-		"setup":                  postHandler(HandleError, handler.New(sdkService.Setup)),
-		"reset":                  postHandler(HandleError, handler.New(sdkService.Reset)),
-		"createAccount":          postHandler(HandleError, handler.New(accountService.CreateAccount)),
-		"updateAccount":          postHandler(HandleError, handler.New(accountService.UpdateAccount)),
-		"deleteAccount":          postHandler(HandleError, handler.New(accountService.DeleteAccount)),
-		"approveAllowance":       postHandler(HandleError, handler.New(accountService.ApproveAllowance)),
-		"deleteAllowance":        postHandler(HandleError, handler.New(accountService.DeleteAllowance)),
-		"transferCrypto":         postHandler(HandleError, handler.New(accountService.TransferCrypto)),
-		"createToken":            postHandler(HandleError, handler.New(tokenService.CreateToken)),
-		"updateToken":            postHandler(HandleError, handler.New(tokenService.UpdateToken)),
-		"deleteToken":            postHandler(HandleError, handler.New(tokenService.DeleteToken)),
-		"updateTokenFeeSchedule": postHandler(HandleError, handler.New(tokenService.UpdateTokenFeeSchedule)),
-		"associateToken":         postHandler(HandleError, handler.New(tokenService.AssociateToken)),
-		"dissociateToken":        postHandler(HandleError, handler.New(tokenService.DissociatesToken)),
-		"pauseToken":             postHandler(HandleError, handler.New(tokenService.PauseToken)),
-		"unpauseToken":           postHandler(HandleError, handler.New(tokenService.UnpauseToken)),
-		"freezeToken":            postHandler(HandleError, handler.New(tokenService.FreezeToken)),
-		"unfreezeToken":          postHandler(HandleError, handler.New(tokenService.UnfreezeToken)),
-		"grantTokenKyc":          postHandler(HandleError, handler.New(tokenService.GrantTokenKyc)),
-		"revokeTokenKyc":         postHandler(HandleError, handler.New(tokenService.RevokeTokenKyc)),
-		"mintToken":              postHandler(HandleError, handler.New(tokenService.MintToken)),
-		"burnToken":              postHandler(HandleError, handler.New(tokenService.BurnToken)),
-		"wipeToken":              postHandler(HandleError, handler.New(tokenService.WipeToken)),
-		"claimToken":             postHandler(HandleError, handler.New(tokenService.ClaimToken)),
-		"airdropToken":           postHandler(HandleError, handler.New(tokenService.AirdropToken)),
-		"cancelAirdrop":          postHandler(HandleError, handler.New(tokenService.CancelAirdrop)),
-		"createFile":             postHandler(HandleError, handler.New(fileService.CreateFile)),
-		"updateFile":             postHandler(HandleError, handler.New(fileService.UpdateFile)),
-		"deleteFile":             postHandler(HandleError, handler.New(fileService.DeleteFile)),
-		"appendFile":             postHandler(HandleError, handler.New(fileService.AppendFile)),
-		"rejectToken":            postHandler(HandleError, handler.New(tokenService.RejectToken)),
-		"generateKey":            postHandler(HandleError, handler.New(methods.GenerateKey)),
+		"setup":                     postHandler(HandleError, handler.New(sdkService.Setup)),
+		"reset":                     postHandler(HandleError, handler.New(sdkService.Reset)),
+		"createAccount":             postHandler(HandleError, handler.New(accountService.CreateAccount)),
+		"updateAccount":             postHandler(HandleError, handler.New(accountService.UpdateAccount)),
+		"deleteAccount":             postHandler(HandleError, handler.New(accountService.DeleteAccount)),
+		"approveAllowance":          postHandler(HandleError, handler.New(accountService.ApproveAllowance)),
+		"deleteAllowance":           postHandler(HandleError, handler.New(accountService.DeleteAllowance)),
+		"transferCrypto":            postHandler(HandleError, handler.New(accountService.TransferCrypto)),
+		"createToken":               postHandler(HandleError, handler.New(tokenService.CreateToken)),
+		"updateToken":               postHandler(HandleError, handler.New(tokenService.UpdateToken)),
+		"deleteToken":               postHandler(HandleError, handler.New(tokenService.DeleteToken)),
+		"updateTokenFeeSchedule":    postHandler(HandleError, handler.New(tokenService.UpdateTokenFeeSchedule)),
+		"associateToken":            postHandler(HandleError, handler.New(tokenService.AssociateToken)),
+		"dissociateToken":           postHandler(HandleError, handler.New(tokenService.DissociatesToken)),
+		"pauseToken":                postHandler(HandleError, handler.New(tokenService.PauseToken)),
+		"unpauseToken":              postHandler(HandleError, handler.New(tokenService.UnpauseToken)),
+		"freezeToken":               postHandler(HandleError, handler.New(tokenService.FreezeToken)),
+		"unfreezeToken":             postHandler(HandleError, handler.New(tokenService.UnfreezeToken)),
+		"grantTokenKyc":             postHandler(HandleError, handler.New(tokenService.GrantTokenKyc)),
+		"revokeTokenKyc":            postHandler(HandleError, handler.New(tokenService.RevokeTokenKyc)),
+		"mintToken":                 postHandler(HandleError, handler.New(tokenService.MintToken)),
+		"burnToken":                 postHandler(HandleError, handler.New(tokenService.BurnToken)),
+		"wipeToken":                 postHandler(HandleError, handler.New(tokenService.WipeToken)),
+		"claimToken":                postHandler(HandleError, handler.New(tokenService.ClaimToken)),
+		"airdropToken":              postHandler(HandleError, handler.New(tokenService.AirdropToken)),
+		"cancelAirdrop":             postHandler(HandleError, handler.New(tokenService.CancelAirdrop)),
+		"createFile":                postHandler(HandleError, handler.New(fileService.CreateFile)),
+		"updateFile":                postHandler(HandleError, handler.New(fileService.UpdateFile)),
+		"deleteFile":                postHandler(HandleError, handler.New(fileService.DeleteFile)),
+		"appendFile":                postHandler(HandleError, handler.New(fileService.AppendFile)),
+		"rejectToken":               postHandler(HandleError, handler.New(tokenService.RejectToken)),
+		"createTopic":               postHandler(HandleError, handler.New(topicService.CreateTopic)),
+		"updateTopic":               postHandler(HandleError, handler.New(topicService.UpdateTopic)),
+		"deleteTopic":               postHandler(HandleError, handler.New(topicService.DeleteTopic)),
+		"submitTopicMessage":        postHandler(HandleError, handler.New(topicService.SubmitTopicMessage)),
+		"createContract":            postHandler(HandleError, handler.New(contractService.CreateContract)),
+		"updateContract":            postHandler(HandleError, handler.New(contractService.UpdateContract)),
+		"deleteContract":            postHandler(HandleError, handler.New(contractService.DeleteContract)),
+		"executeContract":           postHandler(HandleError, handler.New(contractService.ExecuteContract)),
+		"createSchedule":            postHandler(HandleError, handler.New(scheduleService.CreateSchedule)),
+		"signSchedule":              postHandler(HandleError, handler.New(scheduleService.SignSchedule)),
+		"deleteSchedule":            postHandler(HandleError, handler.New(scheduleService.DeleteSchedule)),
+		"createEthereumTransaction": postHandler(HandleError, handler.New(ethereumService.CreateEthereumTransaction)),
+		"generateKey":               postHandler(HandleError, handler.New(methods.GenerateKey)),
 	}
 
 	bridge := jhttp.NewBridge(assigner, nil)
@@ -143,7 +167,7 @@ func postHandler(handler Handler, h jrpc2.Handler) jrpc2.Handler {
 	return func(ctx context.Context, req *jrpc2.Request) (any, error) {
 		res, err := h(ctx, req)
 		if err != nil {
-			log.Printf("Error occurred processing JSON-RPC request: %s, Response error: %s", req, err)
+			log.Printf("Error occurred processing JSON-RPC request: %v, Response error: %s", req, err)
 			return nil, handler(ctx, req, err)
 		}
 		return res, nil
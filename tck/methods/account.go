@@ -377,21 +377,9 @@ func (a *AccountService) DeleteAllowance(_ context.Context, params param.Account
 
 // TransferCrypto jRPC method for transferCrypto
 func (a *AccountService) TransferCrypto(_ context.Context, params param.TransferCryptoParams) (*response.AccountResponse, error) {
-	transaction := hiero.NewTransferTransaction().SetGrpcDeadline(&threeSecondsDuration)
-
-	if params.Transfers == nil {
-		return nil, response.NewInternalError("transferParams is required")
-	}
-
-	transferParams := *params.Transfers
-	if len(transferParams) == 0 {
-		return nil, response.NewInternalError("transferParams is required")
-	}
-
-	for _, transferParam := range transferParams {
-		if err := utils.HandleTransferParam(transaction, transferParam); err != nil {
-			return nil, err
-		}
+	transaction, err := buildTransferCrypto(params)
+	if err != nil {
+		return nil, err
 	}
 
 	if params.CommonTransactionParams != nil {
@@ -413,3 +401,25 @@ func (a *AccountService) TransferCrypto(_ context.Context, params param.Transfer
 
 	return &response.AccountResponse{Status: receipt.Status.String()}, nil
 }
+
+// buildTransferCrypto builds the transaction of transferCrypto, also used when it is scheduled
+func buildTransferCrypto(params param.TransferCryptoParams) (*hiero.TransferTransaction, error) {
+	transaction := hiero.NewTransferTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.Transfers == nil {
+		return nil, response.NewInternalError("transferParams is required")
+	}
+
+	transferParams := *params.Transfers
+	if len(transferParams) == 0 {
+		return nil, response.NewInternalError("transferParams is required")
+	}
+
+	for _, transferParam := range transferParams {
+		if err := utils.HandleTransferParam(transaction, transferParam); err != nil {
+			return nil, err
+		}
+	}
+
+	return transaction, nil
+}
@@ -0,0 +1,278 @@
+package methods
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/tck/param"
+	"github.com/hiero-ledger/hiero-sdk-go/tck/response"
+	"github.com/hiero-ledger/hiero-sdk-go/tck/utils"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+type ContractService struct {
+	sdkService *SDKService
+}
+
+func (c *ContractService) SetSdkService(service *SDKService) {
+	c.sdkService = service
+}
+
+// CreateContract jRPC method for createContract
+func (c *ContractService) CreateContract(_ context.Context, params param.CreateContractParams) (*response.ContractResponse, error) {
+	transaction := hiero.NewContractCreateTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.BytecodeFileId != nil {
+		fileId, err := hiero.FileIDFromString(*params.BytecodeFileId)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetBytecodeFileID(fileId)
+	}
+	if params.Initcode != nil {
+		initcode, err := utils.DecodeHexParam(*params.Initcode)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetBytecode(initcode)
+	}
+	if err := utils.SetKeyIfPresent(params.AdminKey, transaction.SetAdminKey); err != nil {
+		return nil, err
+	}
+	if params.Gas != nil {
+		gas, err := strconv.ParseUint(*params.Gas, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetGas(gas)
+	}
+	if params.InitialBalance != nil {
+		initialBalance, err := strconv.ParseInt(*params.InitialBalance, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetInitialBalance(hiero.HbarFromTinybar(initialBalance))
+	}
+	if params.ConstructorParameters != nil {
+		constructorParameters, err := utils.DecodeHexParam(*params.ConstructorParameters)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetConstructorParametersRaw(constructorParameters)
+	}
+	if params.AutoRenewPeriod != nil {
+		autoRenewPeriodSeconds, err := strconv.ParseInt(*params.AutoRenewPeriod, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetAutoRenewPeriod(time.Duration(autoRenewPeriodSeconds) * time.Second)
+	}
+	if err := utils.SetAccountIDIfPresent(params.AutoRenewAccountId, transaction.SetAutoRenewAccountID); err != nil {
+		return nil, err
+	}
+	if params.Memo != nil {
+		transaction.SetContractMemo(*params.Memo)
+	}
+	if params.MaxAutomaticTokenAssociations != nil {
+		transaction.SetMaxAutomaticTokenAssociations(*params.MaxAutomaticTokenAssociations)
+	}
+	if err := utils.SetAccountIDIfPresent(params.StakedAccountId, transaction.SetStakedAccountID); err != nil {
+		return nil, err
+	}
+	if params.StakedNodeId != nil {
+		stakedNodeId, err := strconv.ParseInt(*params.StakedNodeId, 10, 64)
+		if err != nil {
+			return nil, response.InvalidParams.WithData(err.Error())
+		}
+		transaction.SetStakedNodeID(stakedNodeId)
+	}
+	if params.DeclineStakingReward != nil {
+		transaction.SetDeclineStakingReward(*params.DeclineStakingReward)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, c.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(c.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(c.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var contractId string
+	if receipt.Status == hiero.StatusSuccess && receipt.ContractID != nil {
+		contractId = receipt.ContractID.String()
+	}
+	return &response.ContractResponse{ContractId: contractId, Status: receipt.Status.String()}, nil
+}
+
+// UpdateContract jRPC method for updateContract
+func (c *ContractService) UpdateContract(_ context.Context, params param.UpdateContractParams) (*response.ContractResponse, error) {
+	transaction := hiero.NewContractUpdateTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if err := utils.SetContractIDIfPresent(params.ContractId, transaction.SetContractID); err != nil {
+		return nil, err
+	}
+	if params.AdminKey != nil {
+		key, err := utils.GetKeyFromString(*params.AdminKey)
+		if err != nil {
+			return nil, err
+		}
+		switch key := key.(type) {
+		case hiero.PublicKey:
+			transaction.SetAdminKey(key)
+		case hiero.PrivateKey:
+			transaction.SetAdminKey(key.PublicKey())
+		default:
+			return nil, response.NewInternalError("adminKey of a contract update must be a single key")
+		}
+	}
+	if params.AutoRenewPeriod != nil {
+		autoRenewPeriodSeconds, err := strconv.ParseInt(*params.AutoRenewPeriod, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetAutoRenewPeriod(time.Duration(autoRenewPeriodSeconds) * time.Second)
+	}
+	if err := utils.SetAccountIDIfPresent(params.AutoRenewAccountId, transaction.SetAutoRenewAccountID); err != nil {
+		return nil, err
+	}
+	if params.ExpirationTime != nil {
+		expirationTime, err := strconv.ParseInt(*params.ExpirationTime, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetExpirationTime(time.Unix(expirationTime, 0))
+	}
+	if params.Memo != nil {
+		transaction.SetContractMemo(*params.Memo)
+	}
+	if params.MaxAutomaticTokenAssociations != nil {
+		transaction.SetMaxAutomaticTokenAssociations(*params.MaxAutomaticTokenAssociations)
+	}
+	if err := utils.SetAccountIDIfPresent(params.StakedAccountId, transaction.SetStakedAccountID); err != nil {
+		return nil, err
+	}
+	if params.StakedNodeId != nil {
+		stakedNodeId, err := strconv.ParseInt(*params.StakedNodeId, 10, 64)
+		if err != nil {
+			return nil, response.InvalidParams.WithData(err.Error())
+		}
+		transaction.SetStakedNodeID(stakedNodeId)
+	}
+	if params.DeclineStakingReward != nil {
+		transaction.SetDeclineStakingReward(*params.DeclineStakingReward)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, c.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(c.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(c.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ContractResponse{Status: receipt.Status.String()}, nil
+}
+
+// DeleteContract jRPC method for deleteContract
+func (c *ContractService) DeleteContract(_ context.Context, params param.DeleteContractParams) (*response.ContractResponse, error) {
+	transaction := hiero.NewContractDeleteTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if err := utils.SetContractIDIfPresent(params.ContractId, transaction.SetContractID); err != nil {
+		return nil, err
+	}
+	if err := utils.SetAccountIDIfPresent(params.TransferAccountId, transaction.SetTransferAccountID); err != nil {
+		return nil, err
+	}
+	if err := utils.SetContractIDIfPresent(params.TransferContractId, transaction.SetTransferContractID); err != nil {
+		return nil, err
+	}
+	if params.PermanentRemoval != nil {
+		transaction.SetPermanentRemoval(*params.PermanentRemoval)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, c.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(c.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(c.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ContractResponse{Status: receipt.Status.String()}, nil
+}
+
+// ExecuteContract jRPC method for executeContract
+func (c *ContractService) ExecuteContract(_ context.Context, params param.ExecuteContractParams) (*response.ContractResponse, error) {
+	transaction := hiero.NewContractExecuteTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if err := utils.SetContractIDIfPresent(params.ContractId, transaction.SetContractID); err != nil {
+		return nil, err
+	}
+	if params.Gas != nil {
+		gas, err := strconv.ParseUint(*params.Gas, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetGas(gas)
+	}
+	if params.Amount != nil {
+		amount, err := strconv.ParseInt(*params.Amount, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetPayableAmount(hiero.HbarFromTinybar(amount))
+	}
+	if params.FunctionParameters != nil {
+		functionParameters, err := utils.DecodeHexParam(*params.FunctionParameters)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetFunctionParameters(functionParameters)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, c.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(c.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(c.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ContractResponse{Status: receipt.Status.String()}, nil
+}
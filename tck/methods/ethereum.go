@@ -0,0 +1,70 @@
+package methods
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hiero-ledger/hiero-sdk-go/tck/param"
+	"github.com/hiero-ledger/hiero-sdk-go/tck/response"
+	"github.com/hiero-ledger/hiero-sdk-go/tck/utils"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+type EthereumService struct {
+	sdkService *SDKService
+}
+
+func (e *EthereumService) SetSdkService(service *SDKService) {
+	e.sdkService = service
+}
+
+// CreateEthereumTransaction jRPC method for createEthereumTransaction
+func (e *EthereumService) CreateEthereumTransaction(_ context.Context, params param.CreateEthereumTransactionParams) (*response.ContractResponse, error) {
+	transaction := hiero.NewEthereumTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.EthereumData != nil {
+		ethereumData, err := utils.DecodeHexParam(*params.EthereumData)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetEthereumData(ethereumData)
+	}
+	if params.CallDataFileId != nil {
+		fileId, err := hiero.FileIDFromString(*params.CallDataFileId)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetCallDataFileID(fileId)
+	}
+	if params.MaxGasAllowance != nil {
+		maxGasAllowance, err := strconv.ParseInt(*params.MaxGasAllowance, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetMaxGasAllowanceHbar(hiero.HbarFromTinybar(maxGasAllowance))
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, e.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(e.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(e.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var contractId string
+	if receipt.Status == hiero.StatusSuccess && receipt.ContractID != nil {
+		contractId = receipt.ContractID.String()
+	}
+	return &response.ContractResponse{ContractId: contractId, Status: receipt.Status.String()}, nil
+}
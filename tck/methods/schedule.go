@@ -0,0 +1,166 @@
+package methods
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/tck/param"
+	"github.com/hiero-ledger/hiero-sdk-go/tck/response"
+	"github.com/hiero-ledger/hiero-sdk-go/tck/utils"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+type ScheduleService struct {
+	sdkService *SDKService
+}
+
+func (s *ScheduleService) SetSdkService(service *SDKService) {
+	s.sdkService = service
+}
+
+// CreateSchedule jRPC method for createSchedule
+func (s *ScheduleService) CreateSchedule(_ context.Context, params param.CreateScheduleParams) (*response.ScheduleResponse, error) {
+	transaction := hiero.NewScheduleCreateTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.ScheduledTransaction != nil {
+		scheduledTransaction, err := buildScheduledTransaction(*params.ScheduledTransaction)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := transaction.SetScheduledTransaction(scheduledTransaction); err != nil {
+			return nil, err
+		}
+	}
+	if params.Memo != nil {
+		transaction.SetScheduleMemo(*params.Memo)
+	}
+	if err := utils.SetKeyIfPresent(params.AdminKey, transaction.SetAdminKey); err != nil {
+		return nil, err
+	}
+	if err := utils.SetAccountIDIfPresent(params.PayerAccountId, transaction.SetPayerAccountID); err != nil {
+		return nil, err
+	}
+	if params.ExpirationTime != nil {
+		expirationTime, err := strconv.ParseInt(*params.ExpirationTime, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetExpirationTime(time.Unix(expirationTime, 0))
+	}
+	if params.WaitForExpiry != nil {
+		transaction.SetWaitForExpiry(*params.WaitForExpiry)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, s.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(s.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(s.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.ScheduleResponse{Status: receipt.Status.String()}
+	if receipt.Status == hiero.StatusSuccess {
+		if receipt.ScheduleID != nil {
+			result.ScheduleId = receipt.ScheduleID.String()
+		}
+		if receipt.ScheduledTransactionID != nil {
+			result.TransactionId = receipt.ScheduledTransactionID.String()
+		}
+	}
+	return result, nil
+}
+
+// SignSchedule jRPC method for signSchedule
+func (s *ScheduleService) SignSchedule(_ context.Context, params param.SignScheduleParams) (*response.ScheduleResponse, error) {
+	transaction := hiero.NewScheduleSignTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.ScheduleId != nil {
+		scheduleId, err := hiero.ScheduleIDFromString(*params.ScheduleId)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetScheduleID(scheduleId)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, s.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(s.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(s.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ScheduleResponse{Status: receipt.Status.String()}, nil
+}
+
+// DeleteSchedule jRPC method for deleteSchedule
+func (s *ScheduleService) DeleteSchedule(_ context.Context, params param.DeleteScheduleParams) (*response.ScheduleResponse, error) {
+	transaction := hiero.NewScheduleDeleteTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.ScheduleId != nil {
+		scheduleId, err := hiero.ScheduleIDFromString(*params.ScheduleId)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetScheduleID(scheduleId)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, s.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(s.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(s.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ScheduleResponse{Status: receipt.Status.String()}, nil
+}
+
+// buildScheduledTransaction builds the transaction a JSON-RPC method would execute, to schedule it instead
+func buildScheduledTransaction(scheduled param.ScheduledTransaction) (hiero.TransactionInterface, error) {
+	switch scheduled.Method {
+	case "transferCrypto":
+		var params param.TransferCryptoParams
+		if err := json.Unmarshal(scheduled.Params, &params); err != nil {
+			return nil, response.InvalidParams.WithData(err.Error())
+		}
+		return buildTransferCrypto(params)
+	case "submitTopicMessage":
+		var params param.SubmitTopicMessageParams
+		if err := json.Unmarshal(scheduled.Params, &params); err != nil {
+			return nil, response.InvalidParams.WithData(err.Error())
+		}
+		return buildSubmitTopicMessage(params)
+	default:
+		return nil, response.InvalidParams.WithData("unsupported scheduled transaction method: " + scheduled.Method)
+	}
+}
@@ -0,0 +1,65 @@
+package methods
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/tck/param"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildScheduledTransferCrypto(t *testing.T) {
+	// Given
+	scheduled := param.ScheduledTransaction{
+		Method: "transferCrypto",
+		Params: json.RawMessage(`{"transfers": [
+			{"hbar": {"accountId": "0.0.2", "amount": "-10"}},
+			{"hbar": {"accountId": "0.0.3", "amount": "10"}}
+		]}`),
+	}
+
+	// When
+	transaction, err := buildScheduledTransaction(scheduled)
+
+	// Then
+	require.NoError(t, err)
+	transfer, ok := transaction.(*hiero.TransferTransaction)
+	require.True(t, ok)
+	assert.Equal(t, hiero.HbarFromTinybar(10), transfer.GetHbarTransfers()[hiero.AccountID{Account: 3}])
+}
+
+func TestBuildScheduledSubmitTopicMessage(t *testing.T) {
+	// Given
+	scheduled := param.ScheduledTransaction{
+		Method: "submitTopicMessage",
+		Params: json.RawMessage(`{"topicId": "0.0.7", "message": "hello"}`),
+	}
+
+	// When
+	transaction, err := buildScheduledTransaction(scheduled)
+
+	// Then
+	require.NoError(t, err)
+	submit, ok := transaction.(*hiero.TopicMessageSubmitTransaction)
+	require.True(t, ok)
+	assert.Equal(t, hiero.TopicID{Topic: 7}, submit.GetTopicID())
+	assert.Equal(t, []byte("hello"), submit.GetMessage())
+}
+
+func TestBuildScheduledTransactionFail(t *testing.T) {
+	// Unsupported method
+	_, err := buildScheduledTransaction(param.ScheduledTransaction{Method: "generateKey"})
+	require.Error(t, err)
+
+	// Malformed params
+	_, err = buildScheduledTransaction(param.ScheduledTransaction{Method: "transferCrypto", Params: json.RawMessage(`{"transfers": 1}`)})
+	require.Error(t, err)
+
+	// Missing transfers
+	_, err = buildScheduledTransaction(param.ScheduledTransaction{Method: "transferCrypto", Params: json.RawMessage(`{}`)})
+	require.Error(t, err)
+}
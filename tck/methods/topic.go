@@ -0,0 +1,248 @@
+package methods
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/tck/param"
+	"github.com/hiero-ledger/hiero-sdk-go/tck/response"
+	"github.com/hiero-ledger/hiero-sdk-go/tck/utils"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+type TopicService struct {
+	sdkService *SDKService
+}
+
+func (t *TopicService) SetSdkService(service *SDKService) {
+	t.sdkService = service
+}
+
+// CreateTopic jRPC method for createTopic
+func (t *TopicService) CreateTopic(_ context.Context, params param.CreateTopicParams) (*response.TopicResponse, error) {
+	transaction := hiero.NewTopicCreateTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.Memo != nil {
+		transaction.SetTopicMemo(*params.Memo)
+	}
+	if err := utils.SetKeyIfPresent(params.AdminKey, transaction.SetAdminKey); err != nil {
+		return nil, err
+	}
+	if err := utils.SetKeyIfPresent(params.SubmitKey, transaction.SetSubmitKey); err != nil {
+		return nil, err
+	}
+	if err := utils.SetKeyIfPresent(params.FeeScheduleKey, transaction.SetFeeScheduleKey); err != nil {
+		return nil, err
+	}
+	if params.FeeExemptKeys != nil {
+		keys, err := utils.GetKeysFromStrings(*params.FeeExemptKeys)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetFeeExemptKeys(keys)
+	}
+	if params.AutoRenewPeriod != nil {
+		autoRenewPeriodSeconds, err := strconv.ParseInt(*params.AutoRenewPeriod, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetAutoRenewPeriod(time.Duration(autoRenewPeriodSeconds) * time.Second)
+	}
+	if err := utils.SetAccountIDIfPresent(params.AutoRenewAccountId, transaction.SetAutoRenewAccountID); err != nil {
+		return nil, err
+	}
+	if params.CustomFees != nil {
+		customFees, err := utils.ParseCustomFixedFees(*params.CustomFees)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetCustomFees(customFees)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, t.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(t.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(t.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var topicId string
+	if receipt.Status == hiero.StatusSuccess {
+		topicId = receipt.TopicID.String()
+	}
+	return &response.TopicResponse{TopicId: topicId, Status: receipt.Status.String()}, nil
+}
+
+// UpdateTopic jRPC method for updateTopic
+func (t *TopicService) UpdateTopic(_ context.Context, params param.UpdateTopicParams) (*response.TopicResponse, error) {
+	transaction := hiero.NewTopicUpdateTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.TopicId != nil {
+		topicId, err := hiero.TopicIDFromString(*params.TopicId)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetTopicID(topicId)
+	}
+	if params.Memo != nil {
+		transaction.SetTopicMemo(*params.Memo)
+	}
+	if err := utils.SetKeyIfPresent(params.AdminKey, transaction.SetAdminKey); err != nil {
+		return nil, err
+	}
+	if err := utils.SetKeyIfPresent(params.SubmitKey, transaction.SetSubmitKey); err != nil {
+		return nil, err
+	}
+	if err := utils.SetKeyIfPresent(params.FeeScheduleKey, transaction.SetFeeScheduleKey); err != nil {
+		return nil, err
+	}
+	if params.FeeExemptKeys != nil {
+		keys, err := utils.GetKeysFromStrings(*params.FeeExemptKeys)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetFeeExemptKeys(keys)
+	}
+	if params.AutoRenewPeriod != nil {
+		autoRenewPeriodSeconds, err := strconv.ParseInt(*params.AutoRenewPeriod, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetAutoRenewPeriod(time.Duration(autoRenewPeriodSeconds) * time.Second)
+	}
+	if err := utils.SetAccountIDIfPresent(params.AutoRenewAccountId, transaction.SetAutoRenewAccountID); err != nil {
+		return nil, err
+	}
+	if params.ExpirationTime != nil {
+		expirationTime, err := strconv.ParseInt(*params.ExpirationTime, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetExpirationTime(time.Unix(expirationTime, 0))
+	}
+	if params.CustomFees != nil {
+		customFees, err := utils.ParseCustomFixedFees(*params.CustomFees)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetCustomFees(customFees)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, t.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(t.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(t.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TopicResponse{Status: receipt.Status.String()}, nil
+}
+
+// DeleteTopic jRPC method for deleteTopic
+func (t *TopicService) DeleteTopic(_ context.Context, params param.DeleteTopicParams) (*response.TopicResponse, error) {
+	transaction := hiero.NewTopicDeleteTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.TopicId != nil {
+		topicId, err := hiero.TopicIDFromString(*params.TopicId)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetTopicID(topicId)
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, t.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(t.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(t.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TopicResponse{Status: receipt.Status.String()}, nil
+}
+
+// SubmitTopicMessage jRPC method for submitTopicMessage
+func (t *TopicService) SubmitTopicMessage(_ context.Context, params param.SubmitTopicMessageParams) (*response.TopicResponse, error) {
+	transaction, err := buildSubmitTopicMessage(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.CommonTransactionParams != nil {
+		err := params.CommonTransactionParams.FillOutTransaction(transaction, t.sdkService.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txResponse, err := transaction.Execute(t.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := txResponse.GetReceipt(t.sdkService.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TopicResponse{Status: receipt.Status.String()}, nil
+}
+
+// buildSubmitTopicMessage builds the transaction of submitTopicMessage, also used when it is scheduled
+func buildSubmitTopicMessage(params param.SubmitTopicMessageParams) (*hiero.TopicMessageSubmitTransaction, error) {
+	transaction := hiero.NewTopicMessageSubmitTransaction().SetGrpcDeadline(&threeSecondsDuration)
+
+	if params.TopicId != nil {
+		topicId, err := hiero.TopicIDFromString(*params.TopicId)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetTopicID(topicId)
+	}
+	if params.Message != nil {
+		transaction.SetMessage(*params.Message)
+	}
+	if params.MaxChunks != nil {
+		if *params.MaxChunks <= 0 {
+			return nil, response.NewInternalError("maxChunks must be positive")
+		}
+		transaction.SetMaxChunks(uint64(*params.MaxChunks))
+	}
+	if params.CustomFeeLimits != nil {
+		limits, err := utils.ParseCustomFeeLimits(*params.CustomFeeLimits)
+		if err != nil {
+			return nil, err
+		}
+		transaction.SetCustomFeeLimits(limits)
+	}
+
+	return transaction, nil
+}
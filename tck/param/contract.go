@@ -0,0 +1,50 @@
+package param
+
+// SPDX-License-Identifier: Apache-2.0
+
+type CreateContractParams struct {
+	BytecodeFileId                *string                  `json:"bytecodeFileId"`
+	Initcode                      *string                  `json:"initcode"`
+	AdminKey                      *string                  `json:"adminKey"`
+	Gas                           *string                  `json:"gas"`
+	InitialBalance                *string                  `json:"initialBalance"`
+	ConstructorParameters         *string                  `json:"constructorParameters"`
+	AutoRenewPeriod               *string                  `json:"autoRenewPeriod"`
+	AutoRenewAccountId            *string                  `json:"autoRenewAccountId"`
+	Memo                          *string                  `json:"memo"`
+	MaxAutomaticTokenAssociations *int32                   `json:"maxAutomaticTokenAssociations"`
+	StakedAccountId               *string                  `json:"stakedAccountId"`
+	StakedNodeId                  *string                  `json:"stakedNodeId"`
+	DeclineStakingReward          *bool                    `json:"declineStakingReward"`
+	CommonTransactionParams       *CommonTransactionParams `json:"commonTransactionParams"`
+}
+
+type UpdateContractParams struct {
+	ContractId                    *string                  `json:"contractId"`
+	AdminKey                      *string                  `json:"adminKey"`
+	AutoRenewPeriod               *string                  `json:"autoRenewPeriod"`
+	AutoRenewAccountId            *string                  `json:"autoRenewAccountId"`
+	ExpirationTime                *string                  `json:"expirationTime"`
+	Memo                          *string                  `json:"memo"`
+	MaxAutomaticTokenAssociations *int32                   `json:"maxAutomaticTokenAssociations"`
+	StakedAccountId               *string                  `json:"stakedAccountId"`
+	StakedNodeId                  *string                  `json:"stakedNodeId"`
+	DeclineStakingReward          *bool                    `json:"declineStakingReward"`
+	CommonTransactionParams       *CommonTransactionParams `json:"commonTransactionParams"`
+}
+
+type DeleteContractParams struct {
+	ContractId              *string                  `json:"contractId"`
+	TransferAccountId       *string                  `json:"transferAccountId"`
+	TransferContractId      *string                  `json:"transferContractId"`
+	PermanentRemoval        *bool                    `json:"permanentRemoval"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
+
+type ExecuteContractParams struct {
+	ContractId              *string                  `json:"contractId"`
+	Gas                     *string                  `json:"gas"`
+	Amount                  *string                  `json:"amount"`
+	FunctionParameters      *string                  `json:"functionParameters"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
@@ -0,0 +1,10 @@
+package param
+
+// SPDX-License-Identifier: Apache-2.0
+
+type CreateEthereumTransactionParams struct {
+	EthereumData            *string                  `json:"ethereumData"`
+	CallDataFileId          *string                  `json:"callDataFileId"`
+	MaxGasAllowance         *string                  `json:"maxGasAllowance"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
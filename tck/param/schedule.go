@@ -0,0 +1,32 @@
+package param
+
+// SPDX-License-Identifier: Apache-2.0
+
+import "encoding/json"
+
+type CreateScheduleParams struct {
+	ScheduledTransaction    *ScheduledTransaction    `json:"scheduledTransaction"`
+	Memo                    *string                  `json:"memo"`
+	AdminKey                *string                  `json:"adminKey"`
+	PayerAccountId          *string                  `json:"payerAccountId"`
+	ExpirationTime          *string                  `json:"expirationTime"`
+	WaitForExpiry           *bool                    `json:"waitForExpiry"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
+
+// ScheduledTransaction is the transaction to schedule, given as the name and params of the
+// JSON-RPC method that would otherwise execute it.
+type ScheduledTransaction struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type SignScheduleParams struct {
+	ScheduleId              *string                  `json:"scheduleId"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
+
+type DeleteScheduleParams struct {
+	ScheduleId              *string                  `json:"scheduleId"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
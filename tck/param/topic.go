@@ -0,0 +1,47 @@
+package param
+
+// SPDX-License-Identifier: Apache-2.0
+
+type CreateTopicParams struct {
+	Memo                    *string                  `json:"memo"`
+	AdminKey                *string                  `json:"adminKey"`
+	SubmitKey               *string                  `json:"submitKey"`
+	FeeScheduleKey          *string                  `json:"feeScheduleKey"`
+	FeeExemptKeys           *[]string                `json:"feeExemptKeys"`
+	AutoRenewPeriod         *string                  `json:"autoRenewPeriod"`
+	AutoRenewAccountId      *string                  `json:"autoRenewAccountId"`
+	CustomFees              *[]CustomFee             `json:"customFees"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
+
+type UpdateTopicParams struct {
+	TopicId                 *string                  `json:"topicId"`
+	Memo                    *string                  `json:"memo"`
+	AdminKey                *string                  `json:"adminKey"`
+	SubmitKey               *string                  `json:"submitKey"`
+	FeeScheduleKey          *string                  `json:"feeScheduleKey"`
+	FeeExemptKeys           *[]string                `json:"feeExemptKeys"`
+	AutoRenewPeriod         *string                  `json:"autoRenewPeriod"`
+	AutoRenewAccountId      *string                  `json:"autoRenewAccountId"`
+	ExpirationTime          *string                  `json:"expirationTime"`
+	CustomFees              *[]CustomFee             `json:"customFees"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
+
+type DeleteTopicParams struct {
+	TopicId                 *string                  `json:"topicId"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
+
+type SubmitTopicMessageParams struct {
+	TopicId                 *string                  `json:"topicId"`
+	Message                 *string                  `json:"message"`
+	MaxChunks               *int64                   `json:"maxChunks"`
+	CustomFeeLimits         *[]CustomFeeLimit        `json:"customFeeLimits"`
+	CommonTransactionParams *CommonTransactionParams `json:"commonTransactionParams"`
+}
+
+type CustomFeeLimit struct {
+	PayerId   *string    `json:"payerId"`
+	FixedFees []FixedFee `json:"fixedFees"`
+}
@@ -0,0 +1,8 @@
+package response
+
+// SPDX-License-Identifier: Apache-2.0
+
+type ContractResponse struct {
+	ContractId string `json:"contractId"`
+	Status     string `json:"status"`
+}
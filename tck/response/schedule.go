@@ -0,0 +1,9 @@
+package response
+
+// SPDX-License-Identifier: Apache-2.0
+
+type ScheduleResponse struct {
+	ScheduleId    string `json:"scheduleId"`
+	TransactionId string `json:"transactionId"`
+	Status        string `json:"status"`
+}
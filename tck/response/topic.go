@@ -0,0 +1,8 @@
+package response
+
+// SPDX-License-Identifier: Apache-2.0
+
+type TopicResponse struct {
+	TopicId string `json:"topicId"`
+	Status  string `json:"status"`
+}
@@ -0,0 +1,26 @@
+package utils
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"strings"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// DecodeHexParam decodes a hex encoded bytes parameter, with or without the 0x prefix
+func DecodeHexParam(value string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(value, "0x"))
+}
+
+func SetContractIDIfPresent[T any](contractIDStr *string, setter func(hiero.ContractID) T) error {
+	if contractIDStr != nil {
+		contractID, err := hiero.ContractIDFromString(*contractIDStr)
+		if err != nil {
+			return err
+		}
+		setter(contractID)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package utils
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"github.com/hiero-ledger/hiero-sdk-go/tck/param"
+	"github.com/hiero-ledger/hiero-sdk-go/tck/response"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// GetKeysFromStrings parses every key of a keys parameter
+func GetKeysFromStrings(keyStrs []string) ([]hiero.Key, error) {
+	keys := make([]hiero.Key, 0, len(keyStrs))
+	for _, keyStr := range keyStrs {
+		key, err := GetKeyFromString(keyStr)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// ParseCustomFixedFees parses the custom fees of a topic, which can only be fixed fees
+func ParseCustomFixedFees(paramFees []param.CustomFee) ([]*hiero.CustomFixedFee, error) {
+	fees, err := ParseCustomFees(paramFees)
+	if err != nil {
+		return nil, err
+	}
+
+	fixedFees := make([]*hiero.CustomFixedFee, 0, len(fees))
+	for _, fee := range fees {
+		fixedFee, ok := fee.(*hiero.CustomFixedFee)
+		if !ok {
+			return nil, response.NewInternalError("topics only support fixed custom fees")
+		}
+		fixedFees = append(fixedFees, fixedFee)
+	}
+	return fixedFees, nil
+}
+
+// ParseCustomFeeLimits parses the custom fee limits a payer accepts when submitting a topic message
+func ParseCustomFeeLimits(paramLimits []param.CustomFeeLimit) ([]*hiero.CustomFeeLimit, error) {
+	limits := make([]*hiero.CustomFeeLimit, 0, len(paramLimits))
+	for _, paramLimit := range paramLimits {
+		limit := hiero.NewCustomFeeLimit()
+		if err := SetAccountIDIfPresent(paramLimit.PayerId, limit.SetPayerId); err != nil {
+			return nil, err
+		}
+
+		for i := range paramLimit.FixedFees {
+			fixedFee := &paramLimit.FixedFees[i]
+			fee := hiero.NewCustomFixedFee().SetAmount(ParseIntFromOptional(&fixedFee.Amount))
+			if fixedFee.DenominatingTokenId != nil {
+				tokenId, err := hiero.TokenIDFromString(*fixedFee.DenominatingTokenId)
+				if err != nil {
+					return nil, err
+				}
+				fee.SetDenominatingTokenID(tokenId)
+			}
+			limit.AddCustomFee(fee)
+		}
+
+		limits = append(limits, limit)
+	}
+	return limits, nil
+}